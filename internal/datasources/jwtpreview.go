@@ -0,0 +1,47 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/jwtpreview"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &jwtPreviewDataSource{}
+)
+
+func NewJWTPreviewDataSource() datasource.DataSource {
+	return &jwtPreviewDataSource{}
+}
+
+// A data source that renders JWT templates locally, so it doesn't need a configured client.
+type jwtPreviewDataSource struct{}
+
+func (d *jwtPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_preview"
+}
+
+func (d *jwtPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = jwtpreview.Schema
+}
+
+func (d *jwtPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading jwt_preview data source")
+
+	model := &jwtpreview.JWTPreviewModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Render(helpers.NewHandler(ctx, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	tflog.Info(ctx, "Read jwt_preview data source")
+}
@@ -11,6 +11,14 @@ var docsAccessKey = map[string]string{
 		"created with an `inactive` status.",
 	"expire_time": "The expiration time of the access key as a Unix timestamp. If not set, the key will not expire. " +
 		"Changing this value after creation will require the access key to be replaced.",
+	"expires_in": "The lifetime of the access key relative to its creation time, e.g., `90 days`, as an alternative to " +
+		"`expire_time`. Changing this value after creation will require the access key to be replaced.",
+	"renew_before": "How long before its expiration time the access key should be renewed, e.g., `14 days`. Once the " +
+		"access key is within this duration of its expiration time Terraform will plan to replace it with a " +
+		"new key, or to rotate it when `rotation` is set so the current key remains valid for the `overlap` " +
+		"duration. Can only be used together with `expires_in`.",
+	"expires_at": "The expiration time of the access key in RFC 3339 format, or an empty string if the key does not " +
+		"expire. This value is set by the server and is read-only.",
 	"bound_user_id": "The ID of a user to bind this access key to. When the key is exchanged for a session JWT, the " +
 		"session acts on behalf of the bound user. Changing this value after creation will require the " +
 		"access key to be replaced.",
@@ -28,6 +36,14 @@ var docsAccessKey = map[string]string{
 		"and is read-only.",
 	"cleartext": "The plaintext value of the access key. This is only available after the key is created and cannot " +
 		"be retrieved later. Store this value securely as it is required to exchange the key for a JWT.",
+	"rotation": "Settings for rotating the access key without downtime. When the access key is rotated a new access " +
+		"key is created and the current one remains active for the `overlap` duration, after which it is " +
+		"deactivated.",
+	"previous_id": "The ID of the previous access key after a rotation, or an empty string if there is no previous " +
+		"access key.",
+	"previous_cleartext": "The plaintext value of the previous access key after a rotation, which remains valid until the " +
+		"`overlap` duration has passed, or an empty string if there is no previous access key.",
+	"rotated_time": "The time the access key was created or last rotated, as a Unix timestamp.",
 }
 
 var docsAccessKeyTenant = map[string]string{
@@ -71,6 +87,29 @@ var docsEngine = map[string]string{
 	"created_time": "The creation time of the engine as a Unix timestamp.",
 	"secret": "The plaintext secret for the engine. This is only available after the engine is created and " +
 		"cannot be retrieved later. Store this value securely as it is used to authenticate the engine.",
+	"rotation": "Settings for rotating the engine secret. When the engine secret is rotated the backend issues a new " +
+		"engine secret that replaces the current one right away, so the new value should be distributed in " +
+		"the same `terraform apply`.",
+	"rotated_time": "The time the engine secret was created or last rotated, as a Unix timestamp.",
+}
+
+var docsFGARelations = map[string]string{
+	"project_id": "The ID of the Descope project the relations belong to. Changing this value will require the " +
+		"resource to be deleted and recreated.",
+	"relations": "The relation tuples to create in the project. Only the relations listed here are managed by " +
+		"the resource, and any other relations of the same resource types, such as ones created by the " +
+		"application at runtime, are left as is. Each relation is validated against the `fga` schema in " +
+		"the project's `authorization` settings.",
+}
+
+var docsRelation = map[string]string{
+	"resource_type": "The type of the resource, which must be defined in the FGA schema.",
+	"resource":      "The ID of the resource, e.g., `acme`.",
+	"relation":      "The name of a relation defined for the resource type in the FGA schema.",
+	"target_type":   "The type of the target, which must be allowed by the relation in the FGA schema.",
+	"target": "The ID of the target, e.g., `platform`, or `*` to relate all targets of the type when the " +
+		"relation allows a wildcard, or `platform#member` to relate the subjects in a relation of the target when the " +
+		"relation allows it.",
 }
 
 var docsApplicationScope = map[string]string{
@@ -109,6 +148,10 @@ var docsInboundApp = map[string]string{
 	"client_secret": "The client secret for authenticating this inbound app. This value is generated automatically and " +
 		"cannot be retrieved after the resource is created. Store this value securely.",
 	"force_pkce": "When enabled, the authorization code flow requires PKCE in addition to the normal client authentication. A confidential client must then present both its client secret and a valid PKCE `code_verifier`. Public clients always use PKCE regardless of this setting.",
+	"rotation": "Settings for rotating the client secret. When the client secret is rotated the backend issues a new " +
+		"client secret that replaces the current one right away, so the new value should be distributed in " +
+		"the same `terraform apply`. Cannot be used when `client_secret` is set.",
+	"rotated_time": "The time the client secret was created or last rotated, as a Unix timestamp.",
 }
 
 var docsSessionSettings = map[string]string{
@@ -127,6 +170,14 @@ var docsManagementKey = map[string]string{
 	"expire_time": "The expiration time of the management key as a Unix timestamp. If not set, " +
 		"the key will not expire. Changing this value after creation will require " +
 		"the management key to be replaced.",
+	"expires_in": "The lifetime of the management key relative to its creation time, e.g., `90 days`, as an alternative " +
+		"to `expire_time`. Changing this value after creation will require the management key to be replaced.",
+	"renew_before": "How long before its expiration time the management key should be renewed, e.g., `14 days`. Once the " +
+		"management key is within this duration of its expiration time Terraform will plan to replace it with " +
+		"a new key, or to rotate it when `rotation` is set so the current key remains valid for the `overlap` " +
+		"duration. Can only be used together with `expires_in`.",
+	"expires_at": "The expiration time of the management key in RFC 3339 format, or an empty string if the key does not " +
+		"expire. This value is set by the server and is read-only.",
 	"permitted_ips": "A list of IP addresses or CIDR ranges that are allowed to use this management key. " +
 		"If not set, the key can be used from any IP address.",
 	"rebac": "Access control settings for the management key. This defines the permissions granted " +
@@ -136,6 +187,14 @@ var docsManagementKey = map[string]string{
 	"cleartext": "The plaintext value of the management key. This is only available after the key is " +
 		"created and cannot be retrieved later. Store this value securely as it is required " +
 		"to authenticate API requests.",
+	"rotation": "Settings for rotating the management key without downtime. When the management key is rotated a new " +
+		"management key is created and the current one remains active for the `overlap` duration, after which " +
+		"it is deactivated.",
+	"previous_id": "The ID of the previous management key after a rotation, or an empty string if there is no previous " +
+		"management key.",
+	"previous_cleartext": "The plaintext value of the previous management key after a rotation, which remains valid until the " +
+		"`overlap` duration has passed, or an empty string if there is no previous management key.",
+	"rotated_time": "The time the management key was created or last rotated, as a Unix timestamp.",
 }
 
 var docsProjectRole = map[string]string{
@@ -177,6 +236,8 @@ var docsProject = map[string]string{
 	"lists": "Lists that can be used for various purposes in the project, such as IP allowlists, " +
 		"text lists, or custom JSON data.",
 	"admin_portal": "Admin portal configuration - A hosted page for end users to access and use Descope Widgets",
+	"localization": "Translations of the texts in the screens of the project's flows, so flows can be shown to users in " +
+		"other languages.",
 }
 
 var docsAdminPortalWidget = map[string]string{
@@ -190,6 +251,21 @@ var docsAdminPortal = map[string]string{
 	"widgets":  "The widgets to show in the Admin Portal",
 }
 
+var docsLocalization = map[string]string{
+	"default_locale": "The BCP-47 language tag of the locale that the texts in the flows are written in. The default value " +
+		"is `en`.",
+	"locales": "The translations for each locale, keyed by the locale's BCP-47 language tag, e.g., `de-DE`. Every " +
+		"translated flow is expected to have translations for all of its screen texts in each locale.",
+}
+
+var docsLocale = map[string]string{
+	"data": "The translations for the locale as a JSON object, with the flow ids as keys, and for each flow an " +
+		"object with the screen ids as keys, and for each screen an object that maps text keys to translated " +
+		"texts. A text key is made of the id of a component in the screen and the property that holds the " +
+		"text, e.g., `emailInput.label`. This will usually be set in the `.tf` file using the `data = " +
+		"file(\"...\")` syntax.",
+}
+
 var docsApplications = map[string]string{
 	"oidc_applications":  "Applications using OpenID Connect (OIDC) for authentication.",
 	"saml_applications":  "Applications using SAML for authentication.",
@@ -197,7 +273,11 @@ var docsApplications = map[string]string{
 }
 
 var docsOIDC = map[string]string{
-	"id":          "An optional identifier for the OIDC application.",
+	"id": "An optional identifier for the OIDC application.",
+	"key": "A persistent value that identifies an application uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that applications keep their " +
+		"identifiers even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the application will be removed and a new one will be created instead.",
 	"name":        "A name for the OIDC application.",
 	"description": "A description for the OIDC application.",
 	"logo":        "A logo for the OIDC application. Should be a hosted image URL.",
@@ -229,7 +309,11 @@ var docsOIDC = map[string]string{
 }
 
 var docsSAML = map[string]string{
-	"id":                          "An optional identifier for the SAML application.",
+	"id": "An optional identifier for the SAML application.",
+	"key": "A persistent value that identifies an application uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that applications keep their " +
+		"identifiers even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the application will be removed and a new one will be created instead.",
 	"name":                        "A name for the SAML application.",
 	"description":                 "A description for the SAML application.",
 	"logo":                        "A logo for the SAML application. Should be a hosted image URL.",
@@ -258,9 +342,13 @@ var docsDynamicConfiguration = map[string]string{
 }
 
 var docsManualConfiguration = map[string]string{
-	"acs_url":     "Enter the `ACS URL` from the SP.",
-	"entity_id":   "Enter the `Entity Id` from the SP.",
+	"acs_url":     "Enter the `ACS URL` from the SP. Required unless `metadata_xml` is set.",
+	"entity_id":   "Enter the `Entity Id` from the SP. Required unless `metadata_xml` is set.",
 	"certificate": "Enter the `Certificate` from the SP.",
+	"metadata_xml": "The SP metadata XML document. When set, the `acs_url`, `entity_id` and `certificate` values " +
+		"are taken from the metadata unless they are also set explicitly. The metadata must have an " +
+		"`AssertionConsumerService` with the `HTTP-POST` binding, and any signing certificate in it must " +
+		"be a valid X.509 certificate.",
 }
 
 var docsSSOAppPermission = map[string]string{
@@ -276,7 +364,11 @@ var docsSSOAppRole = map[string]string{
 }
 
 var docsWSFed = map[string]string{
-	"id":                          "An optional identifier for the WS-Fed application.",
+	"id": "An optional identifier for the WS-Fed application.",
+	"key": "A persistent value that identifies an application uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that applications keep their " +
+		"identifiers even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the application will be removed and a new one will be created instead.",
 	"name":                        "A name for the WS-Fed application.",
 	"description":                 "A description for the WS-Fed application.",
 	"logo":                        "A logo for the WS-Fed application. Should be a hosted image URL.",
@@ -311,6 +403,10 @@ var docsRoleGroupMapping = map[string]string{
 var docsAccessKeyAttribute = map[string]string{
 	"id": "An optional identifier for the attribute. This value is called `Machine Name` in the Descope console. " +
 		"If a value is not provided then an appropriate one will be created from the value of `name`.",
+	"key": "A persistent value that identifies an attribute uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that attributes keep their " +
+		"identifiers, even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the attribute will be removed and a new one will be created instead.",
 	"name": "The name of the attribute. This value is called `Display Name` in the Descope console.",
 	"type": "The type of the attribute. Choose one of \"string\", \"number\", \"boolean\", " +
 		"\"singleselect\", \"multiselect\", \"date\".",
@@ -336,6 +432,10 @@ var docsAttributes = map[string]string{
 var docsTenantAttribute = map[string]string{
 	"id": "An optional identifier for the attribute. This value is called `Machine Name` in the Descope console. " +
 		"If a value is not provided then an appropriate one will be created from the value of `name`.",
+	"key": "A persistent value that identifies an attribute uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that attributes keep their " +
+		"identifiers, even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the attribute will be removed and a new one will be created instead.",
 	"name":           "The name of the attribute. This value is called `Display Name` in the Descope console.",
 	"type":           "The type of the attribute. Choose one of \"string\", \"number\", \"boolean\", \"singleselect\", \"multiselect\", \"date\".",
 	"select_options": "When the attribute type is \"multiselect\". A list of options to choose from.",
@@ -349,6 +449,10 @@ var docsTenantAttributeAuthorization = map[string]string{
 var docsUserAttribute = map[string]string{
 	"id": "An optional identifier for the attribute. This value is called `Machine Name` in the Descope console. " +
 		"If a value is not provided then an appropriate one will be created from the value of `name`.",
+	"key": "A persistent value that identifies an attribute uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that attributes keep their " +
+		"identifiers, even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the attribute will be removed and a new one will be created instead.",
 	"name": "The name of the attribute. This value is called `Display Name` in the Descope console.",
 	"type": "The type of the attribute. Choose one of \"string\", \"number\", \"boolean\", " +
 		"\"singleselect\", \"multiselect\", \"date\".",
@@ -450,12 +554,19 @@ var docsOAuthProvider = map[string]string{
 	"description":                "A brief description of the OAuth provider.",
 	"logo":                       "The URL of the logo associated with the OAuth provider.",
 	"issuer":                     "The issuer identifier for the OAuth provider.",
-	"authorization_endpoint":     "The URL that users are redirected to for authorization with the OAuth provider.",
-	"token_endpoint":             "The URL where the application requests an access token from the OAuth provider.",
-	"user_info_endpoint":         "The URL where the application retrieves user information from the OAuth provider.",
-	"jwks_endpoint":              "The URL where the application can retrieve JSON Web Key Sets (JWKS) for the OAuth provider.",
-	"use_client_assertion":       "Use private key JWT (client assertion) instead of client secret.",
-	"claim_mapping":              "Maps OAuth provider claims to Descope user attributes.",
+	"discovery_url": "The URL of the OpenID Connect discovery document of a custom OAuth provider, or its issuer URL " +
+		"in which case `/.well-known/openid-configuration` is appended to it. When set, the document is " +
+		"fetched during planning and the `issuer`, `authorization_endpoint`, `token_endpoint`, " +
+		"`user_info_endpoint`, `jwks_endpoint` and `allowed_grant_types` attributes are filled with its " +
+		"values, unless they are also set explicitly. If the document can't be fetched the existing values " +
+		"are kept with a warning, and fetching can be disabled with the `DESCOPE_SKIP_OIDC_DISCOVERY` " +
+		"environment variable.",
+	"authorization_endpoint": "The URL that users are redirected to for authorization with the OAuth provider.",
+	"token_endpoint":         "The URL where the application requests an access token from the OAuth provider.",
+	"user_info_endpoint":     "The URL where the application retrieves user information from the OAuth provider.",
+	"jwks_endpoint":          "The URL where the application can retrieve JSON Web Key Sets (JWKS) for the OAuth provider.",
+	"use_client_assertion":   "Use private key JWT (client assertion) instead of client secret.",
+	"claim_mapping":          "Maps OAuth provider claims to Descope user attributes.",
 }
 
 var docsAppleKeyGeneratorModel = map[string]string{
@@ -492,10 +603,12 @@ var docsPassword = map[string]string{
 	"disabled": "Setting this to `true` will disallow using this authentication method directly via " +
 		"API and SDK calls. Note that this does not affect authentication flows that are " +
 		"configured to use this authentication method.",
-	"expiration":              "Whether users are required to change their password periodically.",
-	"expiration_weeks":        "The number of weeks after which a user's password expires and they need to replace it.",
-	"lock":                    "Whether the user account should be locked after a specified number of failed login attempts.",
-	"lock_attempts":           "The number of failed login attempts allowed before an account is locked.",
+	"expiration": "Whether users are required to change their password periodically.",
+	"expiration_weeks": "The number of weeks after which a user's password expires and they need to replace it. " +
+		"This is required when `expiration` is enabled.",
+	"lock": "Whether the user account should be locked after a specified number of failed login attempts.",
+	"lock_attempts": "The number of failed login attempts allowed before an account is locked. This is required " +
+		"when `lock` is enabled, and must be greater than `temporary_lock_attempts` when both are enabled.",
 	"temporary_lock":          "Whether the user account should be temporarily locked after a specified number of failed login attempts.",
 	"temporary_lock_attempts": "The number of failed login attempts allowed before an account is temporarily locked.",
 	"temporary_lock_duration": "The amount of time before the user can sign in again after the account is temporarily locked.",
@@ -505,7 +618,7 @@ var docsPassword = map[string]string{
 	"number":                  "Whether passwords must contain at least one number.",
 	"reuse":                   "Whether to forbid password reuse when users change their password.",
 	"reuse_amount": "The number of previous passwords whose hashes are kept to prevent users from " +
-		"reusing old passwords.",
+		"reusing old passwords. This is required when `reuse` is enabled.",
 	"uppercase":  "Whether passwords must contain at least one uppercase letter.",
 	"any_letter": "Whether passwords must contain at least one letter, either uppercase or lowercase.",
 	"disallowed_characters": "Reject passwords containing any of these characters. Each character in the string is " +
@@ -516,6 +629,10 @@ var docsPassword = map[string]string{
 	"enforce_strength": "Use zxcvbn to calculate the strength of a given password and enforce a minimum level of strength.",
 	"mask_errors": "Prevents information about user accounts from being revealed in error messages, e.g., " +
 		"whether a user already exists.",
+	"preset": "A preset that sets the password policy according to a well known standard, one of `nist_800_63b`, " +
+		"`pci_dss_4` or `owasp_asvs_l2`. The preset fills in the `min_length`, `lowercase`, `uppercase`, `number`, " +
+		"`non_alphanumeric`, `any_letter`, `expiration`, `expiration_weeks`, `lock`, `lock_attempts`, `reuse`, " +
+		"`reuse_amount` and `disallow_email_match` attributes, unless they are also set explicitly.",
 	"email_service": "Settings related to sending password reset emails as part of the password feature.",
 }
 
@@ -571,10 +688,16 @@ var docsAuthorization = map[string]string{
 	"roles":       "A list of `Role` objects.",
 	"permissions": "A list of `Permission` objects.",
 	"fga": "The project's FGA schema, configured in the [Descope console](https://app.descope.com/authorization/fga) " +
-		"under the FGA tab. Use the code view to get the schema text and paste it as the value for this attribute.",
+		"under the FGA tab. Use the code view to get the schema text and paste it as the value for this attribute. " +
+		"The schema is checked for errors such as unknown types or relations when planning, and changes to the " +
+		"schema in the project that only affect its formatting are ignored.",
 }
 
 var docsPermission = map[string]string{
+	"key": "A persistent value that identifies a permission uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that permissions keep their " +
+		"identifiers even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the permission will be removed and a new one will be created instead.",
 	"name":        "A name for the permission.",
 	"description": "A description for the permission.",
 }
@@ -592,12 +715,22 @@ var docsRole = map[string]string{
 }
 
 var docsAbuseIPDB = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "The unique AbuseIPDB API key.",
 }
 
 var docsAlloy = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_token":   "The Alloy API token.",
@@ -607,6 +740,11 @@ var docsAlloy = map[string]string{
 }
 
 var docsAmplitude = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "The Amplitude API Key generated for the Descope service.",
@@ -617,6 +755,11 @@ var docsAmplitude = map[string]string{
 }
 
 var docsArkose = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"public_key":  "The public key that's shown in the Keys screen in the Arkose Labs portal.",
@@ -631,11 +774,17 @@ var docsArkose = map[string]string{
 }
 
 var docsAuditWebhook = map[string]string{
-	"name":           "A custom name for your connector.",
-	"description":    "A description of what your connector is used for.",
-	"base_url":       "The base URL to fetch",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
+	"name":              "A custom name for your connector.",
+	"description":       "A description of what your connector is used for.",
+	"base_url":          "The base URL to fetch",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the payload, and the resulting signature will be sent in the " +
 		"`x-descope-webhook-s256` header. The receiving service should use this secret to " +
@@ -647,6 +796,11 @@ var docsAuditWebhook = map[string]string{
 }
 
 var docsAWSS3 = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":              "A custom name for your connector.",
 	"description":       "A description of what your connector is used for.",
 	"auth_type":         "The authentication type to use.",
@@ -664,6 +818,11 @@ var docsAWSS3 = map[string]string{
 }
 
 var docsAWSSESEmailValidation = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":              "A custom name for your connector.",
 	"description":       "A description of what your connector is used for.",
 	"auth_type":         "The authentication type to use.",
@@ -677,6 +836,11 @@ var docsAWSSESEmailValidation = map[string]string{
 }
 
 var docsAWSTranslate = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":              "A custom name for your connector.",
 	"description":       "A description of what your connector is used for.",
 	"access_key_id":     "AWS access key ID.",
@@ -687,6 +851,11 @@ var docsAWSTranslate = map[string]string{
 }
 
 var docsBitsight = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"client_id": "API Client ID issued when you create the credentials in Bitsight Threat " +
@@ -808,6 +977,11 @@ var docsConnectors = map[string]string{
 }
 
 var docsCoralogix = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"endpoint":      "The ingress OpenTelemetry endpoint URL.",
@@ -820,6 +994,11 @@ var docsCoralogix = map[string]string{
 }
 
 var docsCribl = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"endpoint": "The base URL of your Cribl Stream HTTP source. For Cribl Cloud, the default http " +
@@ -839,6 +1018,11 @@ var docsCribl = map[string]string{
 }
 
 var docsDarwinium = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":            "A custom name for your connector.",
 	"description":     "A description of what your connector is used for.",
 	"node_name":       "The name of the Darwinium node.",
@@ -856,6 +1040,11 @@ var docsDarwinium = map[string]string{
 }
 
 var docsDatadog = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "The unique Datadog organization key.",
@@ -875,12 +1064,22 @@ var docsDatadog = map[string]string{
 }
 
 var docsDevRevGrow = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "Authentication to DevRev APIs requires a personal access token (PAT).",
 }
 
 var docsDocebo = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"base_url":      "The Docebo api base url.",
@@ -891,6 +1090,11 @@ var docsDocebo = map[string]string{
 }
 
 var docsEightByEightViber = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"sub_account_id": "The 8x8 sub-account ID is required for the Messaging API.",
@@ -900,6 +1104,11 @@ var docsEightByEightViber = map[string]string{
 }
 
 var docsEightByEightWhatsapp = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"sub_account_id": "The 8x8 sub-account ID is required for the Messaging API.",
@@ -910,19 +1119,30 @@ var docsEightByEightWhatsapp = map[string]string{
 }
 
 var docsElephant = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"access_key":  "The Elephant access key.",
 }
 
 var docsExternalTokenHTTP = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"endpoint": "The endpoint to get the token from (Using POST method). Descope will send the " +
 		"user information in the body of the request, and should return a JSON response " +
 		"with a 'token' string field.",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the base64 encoded payload, and the resulting signature will be " +
 		"sent in the `x-descope-webhook-s256` header. The receiving service should use " +
@@ -933,6 +1153,11 @@ var docsExternalTokenHTTP = map[string]string{
 }
 
 var docsFingerprint = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"public_api_key": "The Fingerprint public API key.",
@@ -944,18 +1169,33 @@ var docsFingerprint = map[string]string{
 }
 
 var docsFingerprintDescope = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"custom_domain": "The custom domain to fetch",
 }
 
 var docsFirebaseAdmin = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":            "A custom name for your connector.",
 	"description":     "A description of what your connector is used for.",
 	"service_account": "The Firebase service account JSON.",
 }
 
 var docsForter = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"site_id":     "The Forter site ID.",
@@ -970,12 +1210,18 @@ var docsForter = map[string]string{
 }
 
 var docsGenericEmailGateway = map[string]string{
-	"name":           "A custom name for your connector.",
-	"description":    "A description of what your connector is used for.",
-	"post_url":       "The URL of the post email request",
-	"sender":         "The sender address",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
+	"name":              "A custom name for your connector.",
+	"description":       "A description of what your connector is used for.",
+	"post_url":          "The URL of the post email request",
+	"sender":            "The sender address",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the base64 encoded payload, and the resulting signature will be " +
 		"sent in the `x-descope-webhook-s256` header. The receiving service should use " +
@@ -986,12 +1232,18 @@ var docsGenericEmailGateway = map[string]string{
 }
 
 var docsGenericSMSGateway = map[string]string{
-	"name":           "A custom name for your connector.",
-	"description":    "A description of what your connector is used for.",
-	"post_url":       "The URL of the post message request",
-	"sender":         "The sender number",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
+	"name":              "A custom name for your connector.",
+	"description":       "A description of what your connector is used for.",
+	"post_url":          "The URL of the post message request",
+	"sender":            "The sender number",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the base64 encoded payload, and the resulting signature will be " +
 		"sent in the `x-descope-webhook-s256` header. The receiving service should use " +
@@ -1002,6 +1254,11 @@ var docsGenericSMSGateway = map[string]string{
 }
 
 var docsGoogleCloudLogging = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"service_account_key": "A Service Account Key JSON file created from a service account on your Google " +
@@ -1015,6 +1272,11 @@ var docsGoogleCloudLogging = map[string]string{
 }
 
 var docsGoogleCloudTranslation = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":                 "A custom name for your connector.",
 	"description":          "A description of what your connector is used for.",
 	"project_id":           "The Google Cloud project ID where the Google Cloud Translation is managed.",
@@ -1022,6 +1284,11 @@ var docsGoogleCloudTranslation = map[string]string{
 }
 
 var docsGoogleMapsPlaces = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"public_api_key": "The Google Maps Places public API key.",
@@ -1031,6 +1298,11 @@ var docsGoogleMapsPlaces = map[string]string{
 }
 
 var docsGroundcover = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"endpoint": "The gRPC OTLP backend endpoint URL. Found in the groundcover console under " +
@@ -1045,6 +1317,11 @@ var docsGroundcover = map[string]string{
 }
 
 var docsHCaptcha = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"site_key": "The site key is used to invoke hCaptcha service on your site or mobile " +
@@ -1062,16 +1339,27 @@ var docsHCaptcha = map[string]string{
 }
 
 var docsHIBP = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 }
 
 var docsHTTP = map[string]string{
-	"name":           "A custom name for your connector.",
-	"description":    "A description of what your connector is used for.",
-	"base_url":       "The base URL to fetch",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
+	"name":              "A custom name for your connector.",
+	"description":       "A description of what your connector is used for.",
+	"base_url":          "The base URL to fetch",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the base64 encoded payload, and the resulting signature will be " +
 		"sent in the `x-descope-webhook-s256` header. The receiving service should use " +
@@ -1106,6 +1394,11 @@ var docsHTTP = map[string]string{
 }
 
 var docsHubSpot = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":         "A custom name for your connector.",
 	"description":  "A description of what your connector is used for.",
 	"access_token": "The HubSpot private API access token generated for the Descope service.",
@@ -1115,6 +1408,11 @@ var docsHubSpot = map[string]string{
 }
 
 var docsIncode = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "Your InCode API key.",
@@ -1123,6 +1421,11 @@ var docsIncode = map[string]string{
 }
 
 var docsIntercom = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"token":       "The Intercom access token.",
@@ -1130,6 +1433,11 @@ var docsIntercom = map[string]string{
 }
 
 var docsLDAP = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"server_url": "The LDAP server URL (e.g., ldap://localhost:389 or ldaps://localhost:636 for " +
@@ -1145,6 +1453,11 @@ var docsLDAP = map[string]string{
 }
 
 var docsLokalise = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_token":   "Lokalise API token.",
@@ -1157,6 +1470,11 @@ var docsLokalise = map[string]string{
 }
 
 var docsMixpanel = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"project_token": "The unique Mixpanel project token used to identify the project where data will " +
@@ -1175,6 +1493,11 @@ var docsMixpanel = map[string]string{
 }
 
 var docsMParticle = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "The mParticle Server to Server Key generated for the Descope service.",
@@ -1188,6 +1511,11 @@ var docsMParticle = map[string]string{
 }
 
 var docsNewRelic = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "Ingest License Key of the account you want to report data to.",
@@ -1202,20 +1530,31 @@ var docsNewRelic = map[string]string{
 }
 
 var docsOpenTelemetry = map[string]string{
-	"name":           "A custom name for your connector.",
-	"description":    "A description of what your connector is used for.",
-	"endpoint":       "The OTLP endpoint URL.",
-	"protocol":       "Protocol to use for OTLP: http or grpc.",
-	"authentication": "Authentication Information",
-	"headers":        "The headers to send with the request",
-	"insecure":       "Will ignore certificate errors raised by the client",
-	"audit_enabled":  "Whether to enable streaming of audit events.",
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
+	"name":              "A custom name for your connector.",
+	"description":       "A description of what your connector is used for.",
+	"endpoint":          "The OTLP endpoint URL.",
+	"protocol":          "Protocol to use for OTLP: http or grpc.",
+	"authentication":    "Authentication Information",
+	"headers":           "The headers to send with the request",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
+	"insecure":          "Will ignore certificate errors raised by the client",
+	"audit_enabled":     "Whether to enable streaming of audit events.",
 	"audit_filters": "Specify which events will be sent to the external audit service (including " +
 		"tenant selection).",
 	"troubleshoot_log_enabled": "Whether to send troubleshooting events.",
 }
 
 var docsPendo = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"base_url": "The Pendo regional domain to send logs to. Default is the US region, " +
@@ -1229,6 +1568,11 @@ var docsPendo = map[string]string{
 }
 
 var docsPingDirectory = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"host":        "PingDirectory's REST API host.",
@@ -1236,6 +1580,11 @@ var docsPingDirectory = map[string]string{
 }
 
 var docsPostmark = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":              "A custom name for your connector.",
 	"description":       "A description of what your connector is used for.",
 	"server_api_token":  "The API token for authenticating with the Postmark server",
@@ -1244,6 +1593,11 @@ var docsPostmark = map[string]string{
 }
 
 var docsRadar = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"public_api_key": "The Radar publishable API key.",
@@ -1254,6 +1608,11 @@ var docsRadar = map[string]string{
 }
 
 var docsRecaptcha = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"site_key": "The site key is used to invoke reCAPTCHA service on your site or mobile " +
@@ -1268,6 +1627,11 @@ var docsRecaptcha = map[string]string{
 }
 
 var docsRecaptchaEnterprise = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"project_id":  "The Google Cloud project ID where the reCAPTCHA Enterprise is managed.",
@@ -1289,6 +1653,11 @@ var docsRecaptchaEnterprise = map[string]string{
 }
 
 var docsRecaptchaV2 = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"site_key": "The reCAPTCHA v2 site key from the Google reCAPTCHA admin console (checkbox / " +
@@ -1301,6 +1670,11 @@ var docsRecaptchaV2 = map[string]string{
 }
 
 var docsRekognition = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":              "A custom name for your connector.",
 	"description":       "A description of what your connector is used for.",
 	"access_key_id":     "The AWS access key ID",
@@ -1310,6 +1684,11 @@ var docsRekognition = map[string]string{
 }
 
 var docsRNDReassigned = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"company_id": "Your RND company ID (e.g., C038612852). Retrieve this from your RND account " +
@@ -1319,6 +1698,11 @@ var docsRNDReassigned = map[string]string{
 }
 
 var docsSalesforce = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"base_url":      "The Salesforce API base URL.",
@@ -1328,6 +1712,11 @@ var docsSalesforce = map[string]string{
 }
 
 var docsSalesforceMarketingCloud = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"subdomain":     "The Salesforce Marketing Cloud endpoint subdomain.",
@@ -1338,6 +1727,11 @@ var docsSalesforceMarketingCloud = map[string]string{
 }
 
 var docsSardine = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"client_id":     "The Sardine Client ID.",
@@ -1347,14 +1741,20 @@ var docsSardine = map[string]string{
 }
 
 var docsSCIM = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"disabled": "Whether to disable this SCIM connector. When disabled, provisioning events will not be " +
 		"sent to the configured endpoint.",
-	"federated_app_id": "The ID of the federated SSO application this SCIM connector is associated with.",
-	"base_url":         "The base URL of the SCIM v2 endpoint that user provisioning events will be sent to.",
-	"authentication":   "Authentication credentials used when sending requests to the SCIM endpoint.",
-	"headers":          "Custom HTTP headers to send with each provisioning request.",
+	"federated_app_id":  "The ID of the federated SSO application this SCIM connector is associated with.",
+	"base_url":          "The base URL of the SCIM v2 endpoint that user provisioning events will be sent to.",
+	"authentication":    "Authentication credentials used when sending requests to the SCIM endpoint.",
+	"headers":           "Custom HTTP headers to send with each provisioning request.",
+	"sensitive_headers": "Sensitive values that are sent along with `headers` but are hidden in plan output, e.g., an `Authorization` header with credentials.",
 	"hmac_secret": "HMAC is a method for message signing with a symmetrical key. This secret will be " +
 		"used to sign the base64 encoded payload, and the resulting signature will be sent " +
 		"in the `x-descope-webhook-s256` header. The receiving service should use this " +
@@ -1364,6 +1764,11 @@ var docsSCIM = map[string]string{
 }
 
 var docsSegment = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"write_key":   "The Segment Write Key generated for the Descope service.",
@@ -1371,6 +1776,11 @@ var docsSegment = map[string]string{
 }
 
 var docsSendGrid = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"sender":         "The sender details that should be displayed in the email message.",
@@ -1382,6 +1792,11 @@ var docsSendGridAuthField = map[string]string{
 }
 
 var docsSES = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"auth_type":     "The authentication type to use.",
@@ -1438,12 +1853,22 @@ var docsHTTPAuthOAuth2ClientCredentialsField = map[string]string{
 }
 
 var docsSlack = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"token":       "The OAuth token for Slack's Bot User, used to authenticate API requests.",
 }
 
 var docsSmartling = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":            "A custom name for your connector.",
 	"description":     "A description of what your connector is used for.",
 	"user_identifier": "The user identifier for the Smartling account.",
@@ -1452,6 +1877,11 @@ var docsSmartling = map[string]string{
 }
 
 var docsSMTP = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"sender":         "The sender details that should be displayed in the email message.",
@@ -1467,6 +1897,11 @@ var docsSMTPAuthField = map[string]string{
 }
 
 var docsSnowflake = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key": "A Snowflake Programmatic Access Token (PAT). The token's user must have CREATE " +
@@ -1489,6 +1924,11 @@ var docsSnowflake = map[string]string{
 }
 
 var docsSNS = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"access_key_id": "AWS Access key ID.",
@@ -1506,6 +1946,11 @@ var docsSNS = map[string]string{
 }
 
 var docsSplunk = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"hec_token":     "An HTTP Event Collector token configured on your Splunk project.",
@@ -1518,6 +1963,11 @@ var docsSplunk = map[string]string{
 }
 
 var docsSQL = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":          "A custom name for your connector.",
 	"description":   "A description of what your connector is used for.",
 	"engine_name":   "The database engine type.",
@@ -1531,6 +1981,11 @@ var docsSQL = map[string]string{
 }
 
 var docsSumoLogic = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":            "A custom name for your connector.",
 	"description":     "A description of what your connector is used for.",
 	"http_source_url": "The URL associated with an HTTP Hosted collector",
@@ -1541,6 +1996,11 @@ var docsSumoLogic = map[string]string{
 }
 
 var docsSupabase = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"auth_type":      "The authentication type to use.",
@@ -1559,6 +2019,11 @@ var docsSupabase = map[string]string{
 }
 
 var docsTelesign = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"customer_id": "The unique Telesign account Customer ID",
@@ -1566,6 +2031,11 @@ var docsTelesign = map[string]string{
 }
 
 var docsTraceable = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"secret_key":  "The Traceable secret key.",
@@ -1573,6 +2043,11 @@ var docsTraceable = map[string]string{
 }
 
 var docsTurnstile = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"site_key": "The site key is used to invoke Turnstile service on your site or mobile " +
@@ -1582,6 +2057,11 @@ var docsTurnstile = map[string]string{
 }
 
 var docsTwilioCore = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"account_sid":    "Twilio Account SID from your Twilio Console.",
@@ -1610,6 +2090,11 @@ var docsTwilioAuthField = map[string]string{
 }
 
 var docsTwilioVerify = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":           "A custom name for your connector.",
 	"description":    "A description of what your connector is used for.",
 	"account_sid":    "Twilio Account SID from your Twilio Console.",
@@ -1619,6 +2104,11 @@ var docsTwilioVerify = map[string]string{
 }
 
 var docsUnibeam = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":            "A custom name for your connector.",
 	"description":     "A description of what your connector is used for.",
 	"base_url":        "Unibeam API base URL.",
@@ -1630,6 +2120,11 @@ var docsUnibeam = map[string]string{
 }
 
 var docsZeroBounce = map[string]string{
+	"key": "A persistent value that identifies a connector uniquely across plan changes and configuration " +
+		"updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors " +
+		"keep their identifiers, and that any flows that use them keep working, even when connector names or " +
+		"other details are changed. Once the `key` is set it should never be changed, otherwise the connector " +
+		"will be removed and a new one will be created instead.",
 	"name":        "A custom name for your connector.",
 	"description": "A description of what your connector is used for.",
 	"api_key":     "The ZeroBounce API key.",
@@ -1661,7 +2156,11 @@ var docsJWTTemplate = map[string]string{
 		"will be added with the user ID.",
 	"add_jti_claim": "When enabled, a unique JWT ID (jti) claim will be added to the token for tracking and preventing replay attacks.",
 	"template": "The JSON template defining the structure and claims of the JWT token. This is expected " +
-		"to be a valid JSON object given as a `string` value.",
+		"to be a valid JSON object given as a `string` value. Claims can use dynamic values such as " +
+		"`@user.email` or `@tenant.customAttributes.plan`, where custom attributes must be defined " +
+		"in the project's `attributes`. Claims that are always set by Descope, such as `exp` or `iat`, " +
+		"are rejected, as are the `sub` claim unless `override_subject_claim` is set and any " +
+		"authorization claims that Descope adds for the `auth_schema`.",
 }
 
 var docsJWTTemplates = map[string]string{
@@ -1670,6 +2169,10 @@ var docsJWTTemplates = map[string]string{
 }
 
 var docsList = map[string]string{
+	"key": "A persistent value that identifies a list uniquely across plan changes and configuration updates. It " +
+		"is used exclusively by the Terraform provider during planning, to ensure that lists keep their " +
+		"identifiers even when their names or other details are changed. Once the `key` is set it should never be " +
+		"changed, otherwise the list will be removed and a new one will be created instead.",
 	"name":        "The name of the list. Maximum length is 100 characters.",
 	"description": "An optional description for the list. Defaults to an empty string if not provided.",
 	"type": "The type of list. Must be one of: " +
@@ -1696,11 +2199,11 @@ var docsInviteSettings = map[string]string{
 }
 
 var docsSessionMigration = map[string]string{
-	"vendor":    "The name of the vendor the sessions are migrated from, in all lowercase.",
+	"vendor":    "The name of the vendor the sessions are migrated from, either `auth0` or `okta`.",
 	"client_id": "The unique client ID for the vendor.",
-	"domain":    "The domain value if needed by the vendor.",
-	"audience":  "The audience value if needed by the vendor.",
-	"issuer":    "An issuer URL if needed by the vendor.",
+	"domain":    "The domain of the vendor's tenant, required when `vendor` is set to `auth0`.",
+	"audience":  "The audience value if needed by the vendor, which must not be set when `vendor` is set to `okta`.",
+	"issuer":    "An issuer URL, required when `vendor` is set to `okta`.",
 	"api_token": "An API token for the vendor, required when `vendor` is set to `okta`.",
 	"loginid_matched_attributes": "A set of attributes from the vendor's user that should be used to match with " +
 		"the Descope user's login ID.",
@@ -1710,7 +2213,8 @@ var docsSessionMigration = map[string]string{
 
 var docsExternalAuthUserMappingItem = map[string]string{
 	"external_key": "The attribute key in the external vendor's user object.",
-	"descope_key":  "The Descope user attribute to map the external key to.",
+	"descope_key": "The Descope user attribute to map the external key to, either a built-in user attribute " +
+		"such as `email` or `givenName`, or the id of a custom user attribute defined in the project's attributes.",
 }
 
 var docsSettings = map[string]string{
@@ -1764,32 +2268,45 @@ var docsSettings = map[string]string{
 
 var docsEmailService = map[string]string{
 	"connector": "The name of the email connector to use for sending emails.",
-	"templates": "A list of email templates for different authentication flows.",
+	"templates": "A list of email templates for different authentication flows. " +
+		"The message body must use the variable the authentication method sends, e.g., `{{code}}` for OTP and `{{link}}` for magic links.",
 }
 
 var docsEmailTemplate = map[string]string{
-	"active":              "Whether this email template is currently active and in use.",
-	"name":                "Unique name for this email template.",
-	"subject":             "Subject line of the email message.",
-	"html_body":           "HTML content of the email message body, required if `use_plain_text_body` isn't set.",
+	"active":  "Whether this email template is currently active and in use.",
+	"name":    "Unique name for this email template.",
+	"subject": "Subject line of the email message.",
+	"html_body": "HTML content of the email message body, required if `use_plain_text_body` isn't set. " +
+		"The HTML must be well-formed, with every element closed in the right order unless its closing tag is optional.",
 	"plain_text_body":     "Plain text version of the email message body, required if `use_plain_text_body` is set to `true`.",
 	"use_plain_text_body": "Whether to use the plain text body instead of HTML for the email.",
 }
 
 var docsTextService = map[string]string{
 	"connector": "The name of the SMS/text connector to use for sending text messages.",
-	"templates": "A list of text message templates for different authentication flows.",
+	"templates": "A list of text message templates for different authentication flows. " +
+		"The message body must use the variable the authentication method sends, e.g., `{{code}}` for OTP and `{{link}}` for magic links.",
+	"segment_budget": "The maximum number of SMS segments a text message is expected to be split into. A warning is shown " +
+		"when planning if any template might exceed this number of segments after its variables are replaced. " +
+		"The default value of `0` disables the check.",
 }
 
 var docsTextTemplate = map[string]string{
 	"active": "Whether this text template is currently active and in use.",
 	"name":   "Unique name for this text template.",
 	"body":   "The content of the text message.",
+	"encoding": "The encoding the text message will be sent with, either `GSM-7` or `UCS-2` if the template " +
+		"contains any character that isn't in the GSM-7 character set. Variables whose values might have such " +
+		"characters, such as `{{user.name}}`, also mean the message might be sent with `UCS-2`, so any " +
+		"variable other than `{{code}}`, `{{link}}` and `{{user.phone}}` is assumed to require it.",
+	"segments": "The worst-case number of SMS segments the text message will be split into after its variables " +
+		"are replaced with values of the maximum expected length.",
 }
 
 var docsVoiceService = map[string]string{
 	"connector": "The name of the voice connector to use for making voice calls.",
-	"templates": "A list of voice message templates for different purposes.",
+	"templates": "A list of voice message templates for different purposes. " +
+		"The message body must use the variable the authentication method sends, e.g., `{{code}}` for OTP and `{{link}}` for magic links.",
 }
 
 var docsVoiceTemplate = map[string]string{
@@ -1802,3 +2319,16 @@ var docsWidget = map[string]string{
 	"data": "The JSON data defining the widget. This will usually be exported as a `.json` file from the Descope console, " +
 		"and set in the `.tf` file using the `data = file(\"...\")` syntax.",
 }
+
+var docsRotation = map[string]string{
+	"rotate_every": "How often the credential should be rotated, e.g., `90 days`. The credential is rotated by the first " +
+		"`terraform apply` after this duration has passed since it was last rotated.",
+	"keepers": "An arbitrary map of values that causes the credential to be rotated whenever any of them change.",
+	"overlap": "How long the previous credential remains valid after a rotation, e.g., `12 hours`. Defaults to `1 day`.",
+}
+
+var docsSecretRotation = map[string]string{
+	"rotate_every": "How often the secret should be rotated, e.g., `90 days`. The secret is rotated by the first " +
+		"`terraform apply` after this duration has passed since it was last rotated.",
+	"keepers": "An arbitrary map of values that causes the secret to be rotated whenever any of them change.",
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/accesskey"
 	"github.com/descope/terraform-provider-descope/internal/models/descoper"
 	"github.com/descope/terraform-provider-descope/internal/models/engine"
+	"github.com/descope/terraform-provider-descope/internal/models/fgarelations"
 	"github.com/descope/terraform-provider-descope/internal/models/inboundapp"
 	"github.com/descope/terraform-provider-descope/internal/models/managementkey"
 	"github.com/descope/terraform-provider-descope/internal/models/project"
@@ -20,9 +21,11 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/project/flows"
 	"github.com/descope/terraform-provider-descope/internal/models/project/jwttemplates"
 	"github.com/descope/terraform-provider-descope/internal/models/project/lists"
+	"github.com/descope/terraform-provider-descope/internal/models/project/localization"
 	"github.com/descope/terraform-provider-descope/internal/models/project/settings"
 	"github.com/descope/terraform-provider-descope/internal/models/project/templates"
 	"github.com/descope/terraform-provider-descope/internal/models/project/widgets"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -34,6 +37,8 @@ func InjectModels() {
 	inject(descoper.RBacAttributes, docsRBac)
 	inject(descoper.DescoperTagRoleAttributes, docsDescoperTagRole)
 	inject(engine.EngineAttributes, docsEngine)
+	inject(fgarelations.FGARelationsAttributes, docsFGARelations)
+	inject(fgarelations.RelationAttributes, docsRelation)
 	inject(inboundapp.ApplicationScopeAttributes, docsApplicationScope)
 	inject(inboundapp.InboundAppAttributes, docsInboundApp)
 	inject(inboundapp.SessionSettingsAttributes, docsSessionSettings)
@@ -172,6 +177,8 @@ func InjectModels() {
 	inject(jwttemplates.JWTTemplateAttributes, docsJWTTemplate)
 	inject(jwttemplates.JWTTemplatesAttributes, docsJWTTemplates)
 	inject(lists.ListAttributes, docsList)
+	inject(localization.LocalizationAttributes, docsLocalization)
+	inject(localization.LocaleAttributes, docsLocale)
 	inject(settings.InviteSettingsAttributes, docsInviteSettings)
 	inject(settings.SessionMigrationAttributes, docsSessionMigration)
 	inject(settings.ExternalAuthUserMappingItemAttributes, docsExternalAuthUserMappingItem)
@@ -183,6 +190,8 @@ func InjectModels() {
 	inject(templates.VoiceServiceAttributes, docsVoiceService)
 	inject(templates.VoiceTemplateAttributes, docsVoiceTemplate)
 	inject(widgets.WidgetAttributes, docsWidget)
+	inject(rotation.RotationAttributes, docsRotation)
+	inject(rotation.SecretRotationAttributes, docsSecretRotation)
 }
 
 func inject(model map[string]schema.Attribute, docs map[string]string) {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
 	"github.com/descope/terraform-provider-descope/internal/models/project"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,6 +33,31 @@ func (e *ProjectEntity) Save(ctx context.Context, target entityTarget) {
 	save(ctx, target, e.Model, e.Diagnostics)
 }
 
+// Runs validations on the project entity data that span multiple sections of the configuration.
+func (e *ProjectEntity) Validate(ctx context.Context) {
+	handler := helpers.NewHandler(ctx, e.Diagnostics)
+	e.Model.Validate(handler)
+}
+
+// Checks the project entity data for risky settings according to the policy in the provider configuration.
+func (e *ProjectEntity) CheckPolicy(ctx context.Context, p *policy.Policy) {
+	handler := helpers.NewHandler(ctx, e.Diagnostics)
+	e.Model.CheckPolicy(handler, p)
+}
+
+// Evaluates any custom policy rules against the data that will be sent in an infra API request. This
+// should only be called when the configuration has no unknown values, as otherwise the conversion
+// of the data might fail on values that are only known after other resources are applied.
+func (e *ProjectEntity) EvaluateRules(ctx context.Context, p *policy.Policy) {
+	if !p.HasRules(policy.ProjectResource) {
+		return
+	}
+	values := e.Values(ctx)
+	if !e.Diagnostics.HasError() {
+		p.EvaluateRules(helpers.NewHandler(ctx, e.Diagnostics), policy.ProjectResource, values)
+	}
+}
+
 // Returns a representation of the project entity data for sending in an infra API request.
 func (e *ProjectEntity) Values(ctx context.Context) map[string]any {
 	handler := helpers.NewHandler(ctx, e.Diagnostics)
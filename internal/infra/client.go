@@ -26,14 +26,22 @@ type Client struct {
 
 	apiClients map[string]*api.Client
 	lock       sync.Mutex
+
+	// The responses of reads made when planning, keyed by the entity and its ID
+	planCache sync.Map
+
+	// The keys whose values are redacted from request and response logs
+	sensitiveKeys SensitiveKeys
 }
 
-func NewClient(version, managementKey, baseURL string) *Client {
+func NewClient(ctx context.Context, version, managementKey, baseURL string, sensitiveKeys SensitiveKeys) *Client {
+	initTracing(ctx, version)
 	return &Client{
 		version:       version,
 		managementKey: managementKey,
 		baseURL:       baseURL,
 		apiClients:    map[string]*api.Client{},
+		sensitiveKeys: sensitiveKeys,
 	}
 }
 
@@ -43,8 +51,10 @@ func (c *Client) Create(ctx context.Context, projectID, entity string, data map[
 		"data":   data,
 	}
 
-	tflog.Info(ctx, "Starting CREATE request", map[string]any{"body": debugRequest(httpBody)})
+	tflog.Info(ctx, "Starting CREATE request", map[string]any{"body": debugRequest(httpBody, c.sensitiveKeys)})
+	ctx, rt := startRequest(ctx, "CREATE", projectID, entity, httpBody)
 	httpRes, err := c.getAPIClient(projectID).DoPostRequest(ctx, "/v1/mgmt/infra", httpBody, nil, c.managementKey)
+	rt.finish(ctx, "CREATE", httpRes, err)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +64,7 @@ func (c *Client) Create(ctx context.Context, projectID, entity string, data map[
 		return nil, err
 	}
 
-	tflog.Info(ctx, "Finished CREATE request", map[string]any{"response": debugResponse(httpRes.BodyStr)})
+	tflog.Info(ctx, "Finished CREATE request", map[string]any{"response": debugResponse(httpRes.BodyStr, c.sensitiveKeys)})
 	return res, nil
 }
 
@@ -64,8 +74,10 @@ func (c *Client) Read(ctx context.Context, projectID, entity, entityID string) (
 		"id":     entityID,
 	}
 
-	tflog.Info(ctx, "Starting READ request", map[string]any{"query": debugRequest(httpQuery)})
+	tflog.Info(ctx, "Starting READ request", map[string]any{"query": debugRequest(httpQuery, c.sensitiveKeys)})
+	ctx, rt := startRequest(ctx, "READ", projectID, entity, nil)
 	httpRes, err := c.getAPIClient(projectID).DoGetRequest(ctx, "/v1/mgmt/infra", &api.HTTPRequest{QueryParams: httpQuery}, c.managementKey)
+	rt.finish(ctx, "READ", httpRes, err)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +87,28 @@ func (c *Client) Read(ctx context.Context, projectID, entity, entityID string) (
 		return nil, err
 	}
 
-	tflog.Info(ctx, "Finished READ request", map[string]any{"response": debugResponse(httpRes.BodyStr)})
+	tflog.Info(ctx, "Finished READ request", map[string]any{"response": debugResponse(httpRes.BodyStr, c.sensitiveKeys)})
+	return res, nil
+}
+
+// Reads an entity like Read, but returns the cached response if the same entity was already read
+// by this client. This should only be used for checks while planning other resources, so that
+// an entity such as the project is only read once rather than once for every resource that's
+// checked against it, and as the entity might be updated later in the same run.
+func (c *Client) PlanRead(ctx context.Context, projectID, entity, entityID string) (*Response, error) {
+	key := entity + "/" + entityID
+	if v, ok := c.planCache.Load(key); ok {
+		if res, ok := v.(*Response); ok {
+			return res, nil
+		}
+	}
+
+	res, err := c.Read(ctx, projectID, entity, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.planCache.Store(key, res)
 	return res, nil
 }
 
@@ -86,8 +119,10 @@ func (c *Client) Update(ctx context.Context, projectID, entity, entityID string,
 		"data":   data,
 	}
 
-	tflog.Info(ctx, "Starting UPDATE request", map[string]any{"body": debugRequest(httpBody)})
+	tflog.Info(ctx, "Starting UPDATE request", map[string]any{"body": debugRequest(httpBody, c.sensitiveKeys)})
+	ctx, rt := startRequest(ctx, "UPDATE", projectID, entity, httpBody)
 	httpRes, err := c.getAPIClient(projectID).DoPutRequest(ctx, "/v1/mgmt/infra", httpBody, nil, c.managementKey)
+	rt.finish(ctx, "UPDATE", httpRes, err)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +132,7 @@ func (c *Client) Update(ctx context.Context, projectID, entity, entityID string,
 		return nil, err
 	}
 
-	tflog.Info(ctx, "Finished UPDATE request", map[string]any{"response": debugResponse(httpRes.BodyStr)})
+	tflog.Info(ctx, "Finished UPDATE request", map[string]any{"response": debugResponse(httpRes.BodyStr, c.sensitiveKeys)})
 	return res, nil
 }
 
@@ -107,8 +142,10 @@ func (c *Client) Delete(ctx context.Context, projectID, entity, entityID string)
 		"id":     entityID,
 	}
 
-	tflog.Info(ctx, "Starting DELETE request", map[string]any{"query": debugRequest(httpQuery)})
+	tflog.Info(ctx, "Starting DELETE request", map[string]any{"query": debugRequest(httpQuery, c.sensitiveKeys)})
+	ctx, rt := startRequest(ctx, "DELETE", projectID, entity, nil)
 	httpRes, err := c.getAPIClient(projectID).DoDeleteRequest(ctx, "/v1/mgmt/infra", &api.HTTPRequest{QueryParams: httpQuery}, c.managementKey)
+	rt.finish(ctx, "DELETE", httpRes, err)
 	if err != nil {
 		return err
 	}
@@ -146,6 +183,10 @@ func makeAPIClient(version, projectID, baseURL string) *api.Client {
 		CustomDefaultHeaders: headers,
 	}
 
+	if tracingOn {
+		params.DefaultClient = newTracingHTTPClient(params)
+	}
+
 	return api.NewClient(params)
 }
 
@@ -3,93 +3,198 @@ package infra
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 var isShallow = os.Getenv("TF_LOG") != "DEBUG"
 
 const (
-	shallowDepth = 5
-	maxLength    = 80
+	shallowDepth  = 5
+	maxLength     = 80
+	redactedValue = "[REDACTED]"
 )
 
-func debugRequest(v any) string {
+func debugRequest(v any, keys SensitiveKeys) string {
 	b, _ := json.Marshal(v)
 	var m map[string]any
 	_ = json.Unmarshal(b, &m)
-	b, _ = json.MarshalIndent(trimmedMap(m), "", "  ")
+	b, _ = json.MarshalIndent(trimmedMap(m, keys), "", "  ")
 	return string(b)
 }
 
-func debugResponse(s string) string {
+func debugResponse(s string, keys SensitiveKeys) string {
 	var m map[string]any
 	_ = json.Unmarshal([]byte(s), &m)
-	b, _ := json.MarshalIndent(trimmedMap(m), "", "  ")
+	b, _ := json.MarshalIndent(trimmedMap(m, keys), "", "  ")
 	return string(b)
 }
 
-func trimmedMap(m map[string]any) map[string]any {
+func trimmedMap(m map[string]any, keys SensitiveKeys) map[string]any {
 	result := map[string]any{}
-	copyMapShallow(result, m, 0)
+	copyMapShallow(result, m, keys, 0)
 	return result
 }
 
-func copyMapShallow(dest, src map[string]any, depth int) {
+func copyMapShallow(dest, src map[string]any, keys SensitiveKeys, depth int) {
 	for k, v := range src {
-		value := v
-		if srcmap, ok := v.(map[string]any); ok {
-			if isShallow && depth >= shallowDepth {
-				value = fmt.Sprintf("Map{len: %d}", len(srcmap))
-			} else {
-				destmap := map[string]any{}
-				copyMapShallow(destmap, srcmap, depth+1)
-				value = destmap
-			}
-		} else if srcstr, ok := v.(string); ok {
-			if len(srcstr) > maxLength {
-				value = srcstr[:maxLength] + "..."
-			} else {
-				value = srcstr
-			}
-		} else if srclist, ok := v.([]any); ok {
-			if isShallow && depth >= shallowDepth {
-				value = fmt.Sprintf("List[len: %d]", len(srclist))
-			} else {
-				var destlist []any
-				copySliceShallow(&destlist, &srclist, depth+1)
-				value = destlist
-			}
+		if keys.isSensitive(k) && hasSecretValue(v) {
+			dest[k] = redactedValue
+		} else if isHeadersKey(k) {
+			dest[k] = copyValueShallow(redactHeaders(v), keys, depth)
+		} else {
+			dest[k] = copyValueShallow(v, keys, depth)
 		}
-		dest[k] = value
 	}
 }
 
-func copySliceShallow(dest, src *[]any, depth int) {
+func copySliceShallow(dest, src *[]any, keys SensitiveKeys, depth int) {
 	for _, v := range *src {
-		value := v
-		if srcmap, ok := v.(map[string]any); ok {
-			if isShallow && depth >= shallowDepth {
-				value = fmt.Sprintf("Map{len: %d}", len(srcmap))
-			} else {
-				destmap := map[string]any{}
-				copyMapShallow(destmap, srcmap, depth+1)
-				value = destmap
-			}
-		} else if srcstr, ok := v.(string); ok {
-			if len(srcstr) > maxLength {
-				value = srcstr[:maxLength] + "..."
-			} else {
-				value = srcstr
-			}
-		} else if srclist, ok := v.([]any); ok {
-			if isShallow && depth >= shallowDepth {
-				value = fmt.Sprintf("List[len: %d]", len(srclist))
-			} else {
-				var destlist []any
-				copySliceShallow(&destlist, &srclist, depth+1)
-				value = destlist
-			}
+		*dest = append(*dest, copyValueShallow(v, keys, depth))
+	}
+}
+
+func copyValueShallow(v any, keys SensitiveKeys, depth int) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if isShallow && depth >= shallowDepth {
+			return fmt.Sprintf("Map{len: %d}", len(v))
+		}
+		destmap := map[string]any{}
+		copyMapShallow(destmap, v, keys, depth+1)
+		return destmap
+	case []any:
+		if isShallow && depth >= shallowDepth {
+			return fmt.Sprintf("List[len: %d]", len(v))
+		}
+		var destlist []any
+		copySliceShallow(&destlist, &v, keys, depth+1)
+		return destlist
+	case string:
+		if len(v) > maxLength {
+			return v[:maxLength] + "..."
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// Redaction
+
+// Key name suffixes that are always considered secret, regardless of the schemas, to catch
+// values that only appear in backend responses. These only match key names that can't be
+// used for anything but secret values, as whole objects and lists are redacted under them.
+var sensitiveKeySuffixes = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"privatekey",
+	"apikey",
+	"rolekey",
+	"cleartext",
+}
+
+// The JSON keys of sensitive attributes that don't match their attribute names, e.g., the
+// clientCert key of the client_certificate attribute in connectors.
+var sensitiveKeyAliases = []string{
+	"clientcert",
+	"cacert",
+	"pemcert",
+}
+
+// The normalized names of the keys whose values are redacted from request and response
+// logs, in addition to the keys that match any of the sensitiveKeySuffixes.
+type SensitiveKeys map[string]bool
+
+// Returns the names of all attributes marked as Sensitive in the schemas, including those in
+// nested objects, along with the JSON keys of any such attributes that don't match their names.
+func NewSensitiveKeys(schemas ...map[string]schema.Attribute) SensitiveKeys {
+	keys := SensitiveKeys{}
+	for _, alias := range sensitiveKeyAliases {
+		keys[alias] = true
+	}
+	for _, attributes := range schemas {
+		keys.add(attributes)
+	}
+	return keys
+}
+
+func (k SensitiveKeys) add(attributes map[string]schema.Attribute) {
+	for name, attr := range attributes {
+		if attr.IsSensitive() {
+			k[normalizeKey(name)] = true
+		}
+		switch attr := attr.(type) {
+		case schema.SingleNestedAttribute:
+			k.add(attr.Attributes)
+		case schema.ListNestedAttribute:
+			k.add(attr.NestedObject.Attributes)
+		case schema.SetNestedAttribute:
+			k.add(attr.NestedObject.Attributes)
+		case schema.MapNestedAttribute:
+			k.add(attr.NestedObject.Attributes)
 		}
-		*dest = append(*dest, value)
 	}
 }
+
+// Values under sensitive keys are redacted even when they're nested objects or lists, e.g., a
+// secret that's sent as a JSON object or a list of tokens, but empty values are left as is to
+// help debug missing secrets, as are flags such as addMagicLinkToken.
+func hasSecretValue(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v != ""
+	case map[string]any:
+		return len(v) > 0
+	case []any:
+		return len(v) > 0
+	default:
+		return false
+	}
+}
+
+// Connectors send their headers as a list of objects with key and value fields, and the values
+// from a sensitive_headers attribute are merged into the same list as the regular headers, so
+// the values of all headers are redacted while their names are left as is.
+func isHeadersKey(key string) bool {
+	return strings.HasSuffix(normalizeKey(key), "headers")
+}
+
+func redactHeaders(v any) any {
+	list, ok := v.([]any)
+	if !ok {
+		return v
+	}
+	result := []any{}
+	for _, item := range list {
+		if header, ok := item.(map[string]any); ok && hasSecretValue(header["value"]) {
+			redacted := map[string]any{}
+			maps.Copy(redacted, header)
+			redacted["value"] = redactedValue
+			item = redacted
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func (k SensitiveKeys) isSensitive(key string) bool {
+	key = normalizeKey(key)
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return k[key]
+}
+
+// Attribute names are in snake case while JSON keys are in camel case, so we compare
+// them after lowercasing and removing any separators, e.g., client_secret and clientSecret.
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
@@ -0,0 +1,183 @@
+package infra
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/project/connectors"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Secret attributes whose JSON key in the connector configuration doesn't match the attribute name.
+var connectorSecretKeys = map[string]string{
+	"service_role_api_key":   "supabaseServiceRoleKey",
+	"service_account_json":   "serviceAccountJSON",
+	"client_certificate":     "clientCert",
+	"ca_certificate":         "caCert",
+	"service_account_secret": "saSecret",
+	"pem_certificate":        "pemCert",
+	"server_api_token":       "serverAPIToken",
+}
+
+// TestRedactDenylist verifies that values of well known secret keys are redacted in both
+// requests and responses even when they weren't registered from a schema.
+func TestRedactDenylist(t *testing.T) {
+	request := debugRequest(map[string]any{
+		"entity": "access_key",
+		"data": map[string]any{
+			"name":         "foo",
+			"password":     "hunter2",
+			"clientSecret": "abc",
+			"auth": map[string]any{
+				"bearerToken": "xyz",
+				"method":      "bearerToken",
+			},
+			"tokenEndpoint": "https://example.com/token",
+		},
+	}, nil)
+	assert.NotContains(t, request, "hunter2")
+	assert.NotContains(t, request, `"abc"`)
+	assert.NotContains(t, request, `"xyz"`)
+	assert.Contains(t, request, `"name": "foo"`)
+	assert.Contains(t, request, `"method": "bearerToken"`)
+	assert.Contains(t, request, `"tokenEndpoint": "https://example.com/token"`)
+
+	response := debugResponse(`{"entity":"access_key","id":"K123","data":{"cleartext":"K123secretvalue","privateKey":"-----BEGIN"}}`, nil)
+	assert.NotContains(t, response, "K123secretvalue")
+	assert.NotContains(t, response, "BEGIN")
+	assert.Contains(t, response, `"id": "K123"`)
+
+	// empty values are left as is to help debug missing secrets
+	empty := debugRequest(map[string]any{"password": ""}, nil)
+	assert.Contains(t, empty, `"password": ""`)
+
+	// objects and lists whose key names merely resemble secrets are left as is
+	other := debugRequest(map[string]any{
+		"accessKey":    map[string]any{"id": "K123", "name": "ci"},
+		"idpCert":      "-----BEGIN CERTIFICATE-----",
+		"accessKeyIds": []any{"K123"},
+	}, nil)
+	assert.Contains(t, other, `"name": "ci"`)
+	assert.Contains(t, other, "BEGIN CERTIFICATE")
+	assert.Contains(t, other, `"K123"`)
+}
+
+// TestRedactNested verifies that secrets are redacted at every depth, including inside lists,
+// and that objects and lists under sensitive keys are redacted entirely.
+func TestRedactNested(t *testing.T) {
+	request := debugRequest(map[string]any{
+		"connectors": []any{
+			map[string]any{
+				"configuration": map[string]any{
+					"servers": []any{
+						map[string]any{"host": "example.com", "password": "hunter2"},
+					},
+					"serviceAccountSecret": map[string]any{"private_key": "-----BEGIN"},
+					"refreshToken":         []any{"abc", "xyz"},
+					"addMagicLinkToken":    true,
+				},
+			},
+		},
+	}, nil)
+	assert.NotContains(t, request, "hunter2")
+	assert.NotContains(t, request, "BEGIN")
+	assert.NotContains(t, request, `"abc"`)
+	assert.NotContains(t, request, `"xyz"`)
+	assert.Contains(t, request, `"host": "example.com"`)
+	assert.Contains(t, request, `"addMagicLinkToken": true`)
+
+	response := debugResponse(`{"ssoSettings":[{"tenants":[{"oidc":{"clientSecret":"abc123"}}]}]}`, nil)
+	assert.NotContains(t, response, "abc123")
+}
+
+// TestRedactSensitiveAttributes verifies that the values of attributes marked as Sensitive in
+// the schemas are redacted even if they don't match any of the known secret key names.
+func TestRedactSensitiveAttributes(t *testing.T) {
+	value := debugRequest(map[string]any{"fooBarQux": "baz"}, nil)
+	assert.Contains(t, value, "baz")
+
+	keys := NewSensitiveKeys(map[string]schema.Attribute{
+		"nested": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"foo_bar_qux": schema.StringAttribute{Sensitive: true},
+			},
+		},
+	})
+
+	value = debugRequest(map[string]any{"fooBarQux": "baz"}, keys)
+	assert.NotContains(t, value, "baz")
+}
+
+// TestRedactConnectorSecrets verifies that every secret field in every connector is redacted
+// when the connector configuration appears in a request or response.
+func TestRedactConnectorSecrets(t *testing.T) {
+	keys := NewSensitiveKeys(connectors.ConnectorsAttributes)
+
+	count := 0
+	for connector, attr := range connectors.ConnectorsAttributes {
+		list, ok := attr.(schema.ListNestedAttribute)
+		require.True(t, ok, "unexpected attribute type for connector %s", connector)
+		for _, name := range collectSensitiveNames(list.NestedObject.Attributes) {
+			key := connectorSecretKeys[name]
+			if key == "" {
+				key = camelCase(name)
+			}
+
+			secret := "s3cr3t-" + connector + "-" + name
+			payload := map[string]any{"data": map[string]any{"connectors": []any{map[string]any{"type": connector, "configuration": map[string]any{key: secret}}}}}
+
+			assert.NotContains(t, debugRequest(payload, keys), secret, "secret %s in connector %s was not redacted in request", key, connector)
+
+			b, err := json.Marshal(payload)
+			require.NoError(t, err)
+			assert.NotContains(t, debugResponse(string(b), keys), secret, "secret %s in connector %s was not redacted in response", key, connector)
+
+			count += 1
+		}
+	}
+	assert.Greater(t, count, 0)
+}
+
+func collectSensitiveNames(attributes map[string]schema.Attribute) (names []string) {
+	for name, attr := range attributes {
+		if attr.IsSensitive() {
+			names = append(names, name)
+		}
+		if nested, ok := attr.(schema.SingleNestedAttribute); ok {
+			names = append(names, collectSensitiveNames(nested.Attributes)...)
+		}
+	}
+	return
+}
+
+func camelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// TestRedactHeaders verifies that the values of connector headers are redacted, as the values
+// from sensitive_headers are sent in the same list as the regular headers.
+func TestRedactHeaders(t *testing.T) {
+	request := debugRequest(map[string]any{
+		"configuration": map[string]any{
+			"headers": []any{
+				map[string]any{"key": "X-Custom-Auth", "value": "hunter2"},
+				map[string]any{"key": "Accept", "value": ""},
+			},
+			"tokenRequestHeaders": []any{
+				map[string]any{"key": "Authorization", "value": "Bearer xyz"},
+			},
+		},
+	}, nil)
+	assert.NotContains(t, request, "hunter2")
+	assert.NotContains(t, request, "xyz")
+	assert.Contains(t, request, `"key": "X-Custom-Auth"`)
+	assert.Contains(t, request, `"key": "Authorization"`)
+	assert.Contains(t, request, `"value": ""`)
+}
@@ -0,0 +1,58 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/descope/go-sdk/descope/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const fgaEntity = "fga"
+
+// Creates the relation tuples in the project's FGA schema using the management API route.
+func (c *Client) CreateRelations(ctx context.Context, projectID string, tuples []any) error {
+	_, err := c.doRelationsRequest(ctx, "CREATE RELATIONS", projectID, api.Routes.ManagementFGACreateRelations(), tuples)
+	return err
+}
+
+// Deletes the relation tuples from the project's FGA schema using the management API route.
+func (c *Client) DeleteRelations(ctx context.Context, projectID string, tuples []any) error {
+	_, err := c.doRelationsRequest(ctx, "DELETE RELATIONS", projectID, api.Routes.ManagementFGADeleteRelations(), tuples)
+	return err
+}
+
+// Checks whether each of the relation tuples holds in the project, returning the check results
+// with the tuple and its allowed value for each of them.
+func (c *Client) CheckRelations(ctx context.Context, projectID string, tuples []any) ([]any, error) {
+	res, err := c.doRelationsRequest(ctx, "CHECK RELATIONS", projectID, api.Routes.ManagementFGACheck(), tuples)
+	if err != nil {
+		return nil, err
+	}
+	results, _ := res["tuples"].([]any)
+	return results, nil
+}
+
+func (c *Client) doRelationsRequest(ctx context.Context, method, projectID, uri string, tuples []any) (map[string]any, error) {
+	httpBody := map[string]any{
+		"tuples": tuples,
+	}
+
+	tflog.Info(ctx, "Starting "+method+" request", map[string]any{"body": debugRequest(httpBody, c.sensitiveKeys)})
+	ctx, rt := startRequest(ctx, method, projectID, fgaEntity, httpBody)
+	httpRes, err := c.getAPIClient(projectID).DoPostRequest(ctx, uri, httpBody, nil, c.managementKey)
+	rt.finish(ctx, method, httpRes, err)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]any{}
+	if httpRes.BodyStr != "" {
+		if err := json.Unmarshal([]byte(httpRes.BodyStr), &res); err != nil {
+			return nil, err
+		}
+	}
+
+	tflog.Info(ctx, "Finished "+method+" request", map[string]any{"response": debugResponse(httpRes.BodyStr, c.sensitiveKeys)})
+	return res, nil
+}
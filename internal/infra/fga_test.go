@@ -0,0 +1,49 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRelations verifies that relation tuples are created, deleted and checked with the FGA
+// management API routes rather than the infra API.
+func TestRelations(t *testing.T) {
+	requests := map[string][]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		tuples, _ := body["tuples"].([]any)
+		requests[r.URL.Path] = tuples
+		switch r.URL.Path {
+		case "/v1/mgmt/fga/relations", "/v1/mgmt/fga/relations/delete":
+			_, _ = w.Write([]byte(`{}`))
+		case "/v1/mgmt/fga/check":
+			_, _ = w.Write([]byte(`{"tuples":[{"allowed":true,"tuple":{"resourceType":"org","resource":"acme","relation":"admin","targetType":"user","target":"alice"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(ctx, "test", "K123", server.URL, nil)
+	tuples := []any{map[string]any{"resourceType": "org", "resource": "acme", "relation": "admin", "targetType": "user", "target": "alice"}}
+
+	require.NoError(t, client.CreateRelations(ctx, "P123", tuples))
+	assert.Equal(t, tuples, requests["/v1/mgmt/fga/relations"])
+
+	require.NoError(t, client.DeleteRelations(ctx, "P123", tuples))
+	assert.Equal(t, tuples, requests["/v1/mgmt/fga/relations/delete"])
+
+	results, err := client.CheckRelations(ctx, "P123", tuples)
+	require.NoError(t, err)
+	assert.Equal(t, tuples, requests["/v1/mgmt/fga/check"])
+	require.Len(t, results, 1)
+	assert.Equal(t, true, results[0].(map[string]any)["allowed"])
+}
@@ -0,0 +1,123 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/descope/go-sdk/descope/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Returned when no entity or more than one entity matches a lookup.
+type LookupError struct {
+	Entity string
+	Field  string
+	Value  string
+	IDs    []string
+}
+
+func (e *LookupError) Error() string {
+	name := strings.ReplaceAll(e.Entity, "_", " ")
+	if len(e.IDs) == 0 {
+		return fmt.Sprintf("No %s found with %s '%s'", name, e.Field, e.Value)
+	}
+	return fmt.Sprintf("Found %d %ss with %s '%s', use one of these IDs instead: %s", len(e.IDs), name, e.Field, e.Value, strings.Join(e.IDs, ", "))
+}
+
+type lookupField func(item map[string]any) string
+
+type lookupQuery struct {
+	method  string
+	uri     string
+	listKey string
+	fields  map[string]lookupField
+}
+
+var lookupQueries = map[string]lookupQuery{
+	"access_key": {
+		method:  http.MethodPost,
+		uri:     api.Routes.ManagementAccessKeySearchAll(),
+		listKey: "keys",
+		fields:  map[string]lookupField{"name": lookupString("name")},
+	},
+	"management_key": {
+		method:  http.MethodGet,
+		uri:     api.Routes.ManagementMgmtKeySearch(),
+		listKey: "keys",
+		fields:  map[string]lookupField{"name": lookupString("name")},
+	},
+	"descoper": {
+		method:  http.MethodPost,
+		uri:     api.Routes.ManagementDescoperSearch(),
+		listKey: "descopers",
+		fields:  map[string]lookupField{"email": lookupString("attributes", "email"), "name": lookupString("attributes", "displayName")},
+	},
+}
+
+// Returns the fields that can be used to look up entities of the given type.
+func LookupFields(entity string) []string {
+	var fields []string
+	for field := range lookupQueries[entity].fields {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// Finds the ID of the single entity whose field matches the value. Values are compared
+// case insensitively, and a LookupError is returned if there isn't exactly one match.
+func (c *Client) Lookup(ctx context.Context, projectID, entity, field, value string) (string, error) {
+	query, ok := lookupQueries[entity]
+	if !ok || query.fields[field] == nil {
+		return "", fmt.Errorf("looking up %s entities by %s is not supported", entity, field)
+	}
+
+	tflog.Info(ctx, "Starting LOOKUP request", map[string]any{"entity": entity, "field": field})
+	ctx, rt := startRequest(ctx, "LOOKUP", projectID, entity, nil)
+	var httpRes *api.HTTPResponse
+	var err error
+	if query.method == http.MethodGet {
+		httpRes, err = c.getAPIClient(projectID).DoGetRequest(ctx, query.uri, nil, c.managementKey)
+	} else {
+		httpRes, err = c.getAPIClient(projectID).DoPostRequest(ctx, query.uri, map[string]any{}, nil, c.managementKey)
+	}
+	rt.finish(ctx, "LOOKUP", httpRes, err)
+	if err != nil {
+		return "", err
+	}
+
+	res := map[string]any{}
+	if err := json.Unmarshal([]byte(httpRes.BodyStr), &res); err != nil {
+		return "", err
+	}
+
+	ids := []string{}
+	items, _ := res[query.listKey].([]any)
+	for _, v := range items {
+		if item, ok := v.(map[string]any); ok && strings.EqualFold(query.fields[field](item), value) {
+			if id, _ := item["id"].(string); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Finished LOOKUP request", map[string]any{"matches": len(ids)})
+	if len(ids) != 1 {
+		return "", &LookupError{Entity: entity, Field: field, Value: value, IDs: ids}
+	}
+	return ids[0], nil
+}
+
+func lookupString(keys ...string) lookupField {
+	return func(item map[string]any) string {
+		for _, key := range keys[:len(keys)-1] {
+			item, _ = item[key].(map[string]any)
+		}
+		value, _ := item[keys[len(keys)-1]].(string)
+		return value
+	}
+}
@@ -0,0 +1,56 @@
+package infra
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLookup verifies that entities are looked up by the supported fields and that a clear
+// error is returned when there isn't exactly one match.
+func TestLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/mgmt/accesskey/search":
+			_, _ = w.Write([]byte(`{"keys":[{"id":"K1","name":"CI Key"},{"id":"K2","name":"Dup"},{"id":"K3","name":"dup"}]}`))
+		case "/v1/mgmt/managementkey/search":
+			_, _ = w.Write([]byte(`{"keys":[{"id":"MK1","name":"Pipeline"}]}`))
+		case "/v1/mgmt/descoper/list":
+			_, _ = w.Write([]byte(`{"descopers":[{"id":"U1","attributes":{"email":"foo@example.com","displayName":"Foo"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(ctx, "test", "K123", server.URL, nil)
+
+	id, err := client.Lookup(ctx, "P123", "access_key", "name", "CI Key")
+	require.NoError(t, err)
+	assert.Equal(t, "K1", id)
+
+	id, err = client.Lookup(ctx, NoProjectID, "management_key", "name", "Pipeline")
+	require.NoError(t, err)
+	assert.Equal(t, "MK1", id)
+
+	id, err = client.Lookup(ctx, NoProjectID, "descoper", "email", "FOO@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "U1", id)
+
+	_, err = client.Lookup(ctx, "P123", "access_key", "name", "Missing")
+	assert.EqualError(t, err, "No access key found with name 'Missing'")
+
+	_, err = client.Lookup(ctx, "P123", "access_key", "name", "dup")
+	assert.EqualError(t, err, "Found 2 access keys with name 'dup', use one of these IDs instead: K2, K3")
+
+	_, err = client.Lookup(ctx, "P123", "inbound_app", "name", "foo")
+	assert.ErrorContains(t, err, "not supported")
+
+	assert.Equal(t, []string{"email", "name"}, LookupFields("descoper"))
+	assert.Empty(t, LookupFields("engine"))
+}
@@ -0,0 +1,54 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/descope/go-sdk/descope/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type rotateQuery struct {
+	uri       string
+	secretKey string
+}
+
+// The management API routes that issue a new secret for entities that have a single secret,
+// rather than being rotated by creating a new entity.
+var rotateQueries = map[string]rotateQuery{
+	"engine": {
+		uri:       api.Routes.ManagementEngineRotateSecret(),
+		secretKey: "secret",
+	},
+	"inbound_app": {
+		uri:       api.Routes.ManagementThirdPartyApplicationRotate(),
+		secretKey: "cleartext",
+	},
+}
+
+// Issues a new secret for the entity and returns it, which replaces the entity's current secret.
+func (c *Client) RotateSecret(ctx context.Context, projectID, entity, entityID string) (string, error) {
+	query, ok := rotateQueries[entity]
+	if !ok {
+		return "", fmt.Errorf("rotating the secret of %s entities is not supported", entity)
+	}
+
+	tflog.Info(ctx, "Starting ROTATE request", map[string]any{"entity": entity, "id": entityID})
+	ctx, rt := startRequest(ctx, "ROTATE", projectID, entity, nil)
+	httpRes, err := c.getAPIClient(projectID).DoPostRequest(ctx, query.uri, map[string]any{"id": entityID}, nil, c.managementKey)
+	rt.finish(ctx, "ROTATE", httpRes, err)
+	if err != nil {
+		return "", err
+	}
+
+	res := map[string]any{}
+	if err := json.Unmarshal([]byte(httpRes.BodyStr), &res); err != nil {
+		return "", err
+	}
+
+	secret, _ := res[query.secretKey].(string)
+
+	tflog.Info(ctx, "Finished ROTATE request", map[string]any{"hasSecret": secret != ""})
+	return secret, nil
+}
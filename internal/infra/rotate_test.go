@@ -0,0 +1,44 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRotateSecret verifies that secrets are rotated with the management API route for each
+// entity type, and that the new secret is read from the entity's response key.
+func TestRotateSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/v1/mgmt/engine/rotate":
+			_, _ = w.Write([]byte(`{"secret":"new-` + body["id"].(string) + `"}`))
+		case "/v1/mgmt/thirdparty/app/rotate":
+			_, _ = w.Write([]byte(`{"cleartext":"new-` + body["id"].(string) + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient(ctx, "test", "K123", server.URL, nil)
+
+	secret, err := client.RotateSecret(ctx, "P123", "engine", "E1")
+	require.NoError(t, err)
+	assert.Equal(t, "new-E1", secret)
+
+	secret, err = client.RotateSecret(ctx, "P123", "inbound_app", "A1")
+	require.NoError(t, err)
+	assert.Equal(t, "new-A1", secret)
+
+	_, err = client.RotateSecret(ctx, "P123", "access_key", "K1")
+	assert.ErrorContains(t, err, "not supported")
+}
@@ -0,0 +1,262 @@
+package infra
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/descope/go-sdk/descope"
+	"github.com/descope/go-sdk/descope/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Tracing is disabled unless the OTEL_TRACES_EXPORTER environment variable is set to one of
+// these values, in which case the exporter is further configured by the standard OTEL_*
+// environment variables, e.g., OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_SERVICE_NAME.
+const (
+	tracesExporterOTLP    = "otlp"
+	tracesExporterConsole = "console"
+	tracesExporterFile    = "file"
+)
+
+const (
+	tracerName      = "github.com/descope/terraform-provider-descope"
+	defaultFilePath = "descope-traces.json"
+)
+
+var (
+	tracer      trace.Tracer = noop.NewTracerProvider().Tracer(tracerName)
+	tracingOnce sync.Once
+	tracingOn   bool
+)
+
+// Initializes the global tracer once per provider process according to the OTEL_* environment
+// variables. Any failure is logged and leaves tracing disabled, as it should never prevent the
+// provider from working.
+func initTracing(ctx context.Context, version string) {
+	tracingOnce.Do(func() {
+		exporter, err := makeSpanExporter(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to initialize tracing exporter", map[string]any{"error": err.Error()})
+			return
+		}
+		if exporter == nil {
+			return
+		}
+
+		res, err := resource.New(ctx,
+			resource.WithAttributes(attribute.String("service.name", "terraform-provider-descope"), attribute.String("service.version", version)),
+			resource.WithFromEnv(),
+			resource.WithTelemetrySDK(),
+		)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to create tracing resource", map[string]any{"error": err.Error()})
+		}
+
+		// the provider process can be killed at any time without a shutdown hook, so we use
+		// a synchronous span processor to ensure spans are exported as soon as they end
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter), sdktrace.WithResource(res))
+		tracer = provider.Tracer(tracerName, trace.WithInstrumentationVersion(version))
+		tracingOn = true
+
+		tflog.Info(ctx, "Tracing enabled for provider operations", map[string]any{"exporter": os.Getenv("OTEL_TRACES_EXPORTER")})
+	})
+}
+
+func makeSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return nil, nil
+	}
+
+	switch v := os.Getenv("OTEL_TRACES_EXPORTER"); v {
+	case "", "none":
+		return nil, nil
+	case tracesExporterOTLP:
+		if p := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); p != "" && p != "http/protobuf" {
+			return nil, fmt.Errorf("unsupported OTLP protocol '%s', only http/protobuf is supported", p)
+		}
+		return otlptracehttp.New(ctx)
+	case tracesExporterConsole:
+		// stdout is used by the plugin protocol so spans are written to stderr instead
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+	case tracesExporterFile:
+		path := os.Getenv("OTEL_EXPORTER_FILE_PATH")
+		if path == "" {
+			path = defaultFilePath
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		return stdouttrace.New(stdouttrace.WithWriter(f))
+	default:
+		return nil, fmt.Errorf("unsupported traces exporter '%s', expected one of %s, %s or %s", v, tracesExporterOTLP, tracesExporterConsole, tracesExporterFile)
+	}
+}
+
+// Resource operations
+
+// A span that wraps a single CRUD operation on a Terraform resource.
+type Operation struct {
+	span  trace.Span
+	start time.Time
+}
+
+// Starts a span for a CRUD operation on a resource, the returned context should be passed
+// to the client so any infra requests made during the operation are nested under it.
+func StartOperation(ctx context.Context, operation, entity string) (context.Context, *Operation) {
+	ctx, span := tracer.Start(ctx, operation+" "+entity, trace.WithAttributes(
+		attribute.String("descope.operation", operation),
+		attribute.String("descope.entity", entity),
+	))
+	return ctx, &Operation{span: span, start: time.Now()}
+}
+
+// Adds the ID of the project the resource belongs to once it's known.
+func (o *Operation) SetProjectID(projectID string) {
+	if projectID != "" {
+		o.span.SetAttributes(attribute.String("descope.project_id", projectID))
+	}
+}
+
+// Ends the span, marking it as failed if there are any errors in the diagnostics.
+func (o *Operation) End(ctx context.Context, diags *diag.Diagnostics) {
+	if errs := diags.Errors(); len(errs) > 0 {
+		o.span.SetStatus(codes.Error, errs[0].Summary())
+	}
+	o.span.End()
+	tflog.Debug(ctx, "Resource operation timing", map[string]any{"duration": time.Since(o.start).String()})
+}
+
+// Infra requests
+
+type requestStatsKey struct{}
+
+// Collected by the tracing HTTP client over all attempts made when sending a request.
+type requestStats struct {
+	attempts atomic.Int32
+}
+
+// A span that wraps a single infra request, including any retries made by the API client.
+type requestTrace struct {
+	span  trace.Span
+	start time.Time
+	stats *requestStats
+}
+
+func startRequest(ctx context.Context, method, projectID, entity string, payload any) (context.Context, *requestTrace) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", method),
+		attribute.String("descope.entity", entity),
+	}
+	if projectID != NoProjectID {
+		attrs = append(attrs, attribute.String("descope.project_id", projectID))
+	}
+	if payload != nil {
+		if b, err := json.Marshal(payload); err == nil {
+			attrs = append(attrs, attribute.Int("descope.payload_size", len(b)))
+		}
+	}
+
+	stats := &requestStats{}
+	ctx = context.WithValue(ctx, requestStatsKey{}, stats)
+	ctx, span := tracer.Start(ctx, "infra "+method+" "+entity, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	return ctx, &requestTrace{span: span, start: time.Now(), stats: stats}
+}
+
+// Ends the request span and logs the request timing.
+func (t *requestTrace) finish(ctx context.Context, method string, res *api.HTTPResponse, err error) {
+	fields := map[string]any{"duration": time.Since(t.start).String()}
+
+	if status := responseStatusCode(res, err); status != 0 {
+		fields["status"] = status
+		t.span.SetAttributes(attribute.Int("http.response.status_code", status))
+	}
+	if res != nil {
+		t.span.SetAttributes(attribute.Int("descope.response_size", len(res.BodyStr)))
+	}
+	if attempts := int(t.stats.attempts.Load()); attempts > 0 {
+		fields["retries"] = attempts - 1
+		t.span.SetAttributes(attribute.Int("descope.retry_count", attempts-1))
+	}
+	if err != nil {
+		t.span.RecordError(err)
+		t.span.SetStatus(codes.Error, err.Error())
+	}
+	t.span.End()
+
+	tflog.Info(ctx, "Timing for "+method+" request", fields)
+}
+
+func responseStatusCode(res *api.HTTPResponse, err error) int {
+	if res != nil && res.Res != nil {
+		return res.Res.StatusCode
+	}
+	var descopeErr *descope.Error
+	if errors.As(err, &descopeErr) {
+		if status, ok := descopeErr.Info[descope.ErrorInfoKeys.HTTPResponseStatusCode].(int); ok {
+			return status
+		}
+	}
+	return 0
+}
+
+// An HTTP client for the API client that counts the attempts made for each request, as the API
+// client retries requests internally on some status codes. This is only used when tracing is
+// enabled to ensure the API client's default client is used otherwise, and it's configured the
+// same way as the API client's default client, with its transport wrapped to count the attempts.
+func newTracingHTTPClient(params api.ClientParams) *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	if t, ok := http.DefaultTransport.(*http.Transport); ok {
+		t = t.Clone()
+		t.MaxIdleConns = 100
+		t.MaxConnsPerHost = 100
+		t.MaxIdleConnsPerHost = 100
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{}
+		}
+		t.TLSClientConfig.InsecureSkipVerify = params.CertificateVerify.SkipVerifyValue(params.BaseURL)
+		transport = t
+	}
+
+	timeout := 60 * time.Second
+	if params.RequestTimeout != 0 {
+		timeout = params.RequestTimeout
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &tracingTransport{transport: transport},
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type tracingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if stats, ok := req.Context().Value(requestStatsKey{}).(*requestStats); ok {
+		stats.attempts.Add(1)
+	}
+	return t.transport.RoundTrip(req)
+}
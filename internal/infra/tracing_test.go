@@ -0,0 +1,101 @@
+package infra
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/descope/go-sdk/descope/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpanExporter verifies that the traces exporter is selected according to the standard environment variables.
+func TestSpanExporter(t *testing.T) {
+	ctx := context.Background()
+
+	exporter, err := makeSpanExporter(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, exporter)
+
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	exporter, err = makeSpanExporter(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, exporter)
+
+	t.Setenv("OTEL_TRACES_EXPORTER", "file")
+	t.Setenv("OTEL_EXPORTER_FILE_PATH", filepath.Join(t.TempDir(), "traces.json"))
+	exporter, err = makeSpanExporter(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, exporter)
+
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	exporter, err = makeSpanExporter(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, exporter)
+
+	t.Setenv("OTEL_SDK_DISABLED", "")
+	t.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+	_, err = makeSpanExporter(ctx)
+	assert.ErrorContains(t, err, "unsupported traces exporter")
+
+	t.Setenv("OTEL_TRACES_EXPORTER", "otlp")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+	_, err = makeSpanExporter(ctx)
+	assert.ErrorContains(t, err, "unsupported OTLP protocol")
+}
+
+// TestRequestRetries verifies that the tracing HTTP client counts every attempt made by the
+// API client, including the internal retries on service unavailable responses.
+func TestRequestRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls += 1
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entity":"project","id":"P123","data":{}}`))
+	}))
+	defer server.Close()
+
+	params := api.ClientParams{ProjectID: "P123", BaseURL: server.URL}
+	params.DefaultClient = newTracingHTTPClient(params)
+	client := api.NewClient(params)
+
+	ctx, rt := startRequest(context.Background(), "READ", "P123", "project", nil)
+	res, err := client.DoGetRequest(ctx, "/v1/mgmt/infra", nil, "")
+	rt.finish(ctx, "READ", res, err)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, responseStatusCode(res, err))
+	assert.EqualValues(t, 2, rt.stats.attempts.Load())
+}
+
+// TestRequestTLS verifies that the tracing HTTP client keeps the API client's TLS configuration,
+// which skips certificate verification for local base URLs unless configured otherwise.
+func TestRequestTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"entity":"project","id":"P123","data":{}}`))
+	}))
+	defer server.Close()
+
+	params := api.ClientParams{ProjectID: "P123", BaseURL: server.URL}
+	params.DefaultClient = newTracingHTTPClient(params)
+	client := api.NewClient(params)
+
+	ctx, rt := startRequest(context.Background(), "READ", "P123", "project", nil)
+	res, err := client.DoGetRequest(ctx, "/v1/mgmt/infra", nil, "")
+	rt.finish(ctx, "READ", res, err)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, rt.stats.attempts.Load())
+
+	params.CertificateVerify = api.CertificateVerifyAlways
+	params.DefaultClient = newTracingHTTPClient(params)
+	client = api.NewClient(params)
+
+	_, err = client.DoGetRequest(context.Background(), "/v1/mgmt/infra", nil, "")
+	require.Error(t, err)
+}
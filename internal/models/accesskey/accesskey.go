@@ -2,35 +2,49 @@ package accesskey
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/durationattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strlistattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keyexpiry"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var AccessKeyAttributes = map[string]schema.Attribute{
-	"id":                stringattr.Identifier(),
-	"project_id":        stringattr.Required(stringplanmodifier.RequiresReplace()),
-	"name":              stringattr.Required(),
-	"description":       stringattr.Default("", stringattr.StandardLenValidator),
-	"status":            stringattr.Default("active", stringvalidator.OneOf("active", "inactive")),
-	"expire_time":       intattr.Default(0, int64planmodifier.RequiresReplace()),
-	"bound_user_id":     stringattr.Optional(stringplanmodifier.RequiresReplace()),
-	"roles":             strlistattr.Default(stringattr.NonEmptyValidator),
-	"tenants":           listattr.Default[AccessKeyTenantModel](AccessKeyTenantAttributes),
-	"custom_claims":     stringattr.Default("{}", stringattr.JSONValidator()),
-	"custom_attributes": stringattr.Default("{}", stringattr.JSONValidator()),
-	"permitted_ips":     strlistattr.Default(),
-	"client_id":         stringattr.Identifier(),
-	"created_time":      intattr.Generated(),
-	"created_by":        stringattr.Generated(),
-	"cleartext":         stringattr.SecretGenerated(false),
+	"id":                 stringattr.Identifier(),
+	"project_id":         stringattr.Required(stringplanmodifier.RequiresReplace()),
+	"name":               stringattr.Required(),
+	"description":        stringattr.Default("", stringattr.StandardLenValidator),
+	"status":             stringattr.Default("active", stringvalidator.OneOf("active", "inactive")),
+	"expire_time":        intattr.Default(0, int64planmodifier.RequiresReplace()),
+	"expires_in":         durationattr.ConfigOnly(stringplanmodifier.RequiresReplace()),
+	"renew_before":       durationattr.ConfigOnly(),
+	"expires_at":         stringattr.Generated(),
+	"bound_user_id":      stringattr.Optional(stringplanmodifier.RequiresReplace()),
+	"roles":              strlistattr.Default(stringattr.NonEmptyValidator),
+	"tenants":            listattr.Default[AccessKeyTenantModel](AccessKeyTenantAttributes),
+	"custom_claims":      stringattr.Default("{}", stringattr.JSONValidator()),
+	"custom_attributes":  stringattr.Default("{}", stringattr.JSONValidator()),
+	"permitted_ips":      strlistattr.Default(),
+	"client_id":          stringattr.Identifier(),
+	"created_time":       intattr.Generated(),
+	"created_by":         stringattr.Generated(),
+	"cleartext":          stringattr.SecretGenerated(false),
+	"rotation":           rotation.Attribute(),
+	"previous_id":        stringattr.Generated(),
+	"previous_cleartext": stringattr.SecretGenerated(false),
+	"rotated_time":       intattr.Generated(),
 }
 
 var Schema = schema.Schema{
@@ -38,22 +52,29 @@ var Schema = schema.Schema{
 }
 
 type AccessKeyModel struct {
-	ID               stringattr.Type                     `tfsdk:"id"`
-	ProjectID        stringattr.Type                     `tfsdk:"project_id"`
-	Name             stringattr.Type                     `tfsdk:"name"`
-	Description      stringattr.Type                     `tfsdk:"description"`
-	Status           stringattr.Type                     `tfsdk:"status"`
-	ExpireTime       intattr.Type                        `tfsdk:"expire_time"`
-	BoundUserID      stringattr.Type                     `tfsdk:"bound_user_id"`
-	Roles            strlistattr.Type                    `tfsdk:"roles"`
-	Tenants          listattr.Type[AccessKeyTenantModel] `tfsdk:"tenants"`
-	CustomClaims     stringattr.Type                     `tfsdk:"custom_claims"`
-	CustomAttributes stringattr.Type                     `tfsdk:"custom_attributes"`
-	PermittedIPs     strlistattr.Type                    `tfsdk:"permitted_ips"`
-	ClientID         stringattr.Type                     `tfsdk:"client_id"`
-	CreatedTime      intattr.Type                        `tfsdk:"created_time"`
-	CreatedBy        stringattr.Type                     `tfsdk:"created_by"`
-	Cleartext        stringattr.Type                     `tfsdk:"cleartext"`
+	ID                stringattr.Type                      `tfsdk:"id"`
+	ProjectID         stringattr.Type                      `tfsdk:"project_id"`
+	Name              stringattr.Type                      `tfsdk:"name"`
+	Description       stringattr.Type                      `tfsdk:"description"`
+	Status            stringattr.Type                      `tfsdk:"status"`
+	ExpireTime        intattr.Type                         `tfsdk:"expire_time"`
+	ExpiresIn         durationattr.Type                    `tfsdk:"expires_in"`
+	RenewBefore       durationattr.Type                    `tfsdk:"renew_before"`
+	ExpiresAt         stringattr.Type                      `tfsdk:"expires_at"`
+	BoundUserID       stringattr.Type                      `tfsdk:"bound_user_id"`
+	Roles             strlistattr.Type                     `tfsdk:"roles"`
+	Tenants           listattr.Type[AccessKeyTenantModel]  `tfsdk:"tenants"`
+	CustomClaims      stringattr.Type                      `tfsdk:"custom_claims"`
+	CustomAttributes  stringattr.Type                      `tfsdk:"custom_attributes"`
+	PermittedIPs      strlistattr.Type                     `tfsdk:"permitted_ips"`
+	ClientID          stringattr.Type                      `tfsdk:"client_id"`
+	CreatedTime       intattr.Type                         `tfsdk:"created_time"`
+	CreatedBy         stringattr.Type                      `tfsdk:"created_by"`
+	Cleartext         stringattr.Type                      `tfsdk:"cleartext"`
+	Rotation          objattr.Type[rotation.RotationModel] `tfsdk:"rotation"`
+	PreviousID        stringattr.Type                      `tfsdk:"previous_id"`
+	PreviousCleartext stringattr.Type                      `tfsdk:"previous_cleartext"`
+	RotatedTime       intattr.Type                         `tfsdk:"rotated_time"`
 }
 
 func (m *AccessKeyModel) Values(h *helpers.Handler) map[string]any {
@@ -61,7 +82,7 @@ func (m *AccessKeyModel) Values(h *helpers.Handler) map[string]any {
 	stringattr.Get(m.Name, data, "name")
 	stringattr.Get(m.Description, data, "description")
 	stringattr.Get(m.Status, data, "status")
-	intattr.Get(m.ExpireTime, data, "expireTime")
+	keyexpiry.Get(m.ExpireTime, m.ExpiresIn, m.ExpiresAt, data, "expireTime", time.Now())
 	stringattr.Get(m.BoundUserID, data, "boundUserId")
 	strlistattr.Get(m.Roles, data, "roleNames", h)
 	listattr.Get(m.Tenants, data, "keyTenants", h)
@@ -86,7 +107,7 @@ func (m *AccessKeyModel) SetValues(h *helpers.Handler, data map[string]any) {
 	stringattr.Set(&m.Name, data, "name")
 	stringattr.Set(&m.Description, data, "description")
 	stringattr.Set(&m.Status, data, "status")
-	intattr.Set(&m.ExpireTime, data, "expireTime")
+	keyexpiry.Set(&m.ExpireTime, m.ExpiresIn, &m.ExpiresAt, data, "expireTime")
 	stringattr.Set(&m.BoundUserID, data, "boundUserId")
 	strlistattr.Set(&m.Roles, data, "roleNames", h)
 	listattr.Set(&m.Tenants, data, "keyTenants", h)
@@ -99,6 +120,41 @@ func (m *AccessKeyModel) SetValues(h *helpers.Handler, data map[string]any) {
 	stringattr.Set(&m.Cleartext, data, "cleartext")
 }
 
+func (m *AccessKeyModel) ModifyPlan(h *helpers.Handler, state *AccessKeyModel) path.Paths {
+	var expiresAt stringattr.Type
+	var stateFields *rotation.Fields
+	if state != nil {
+		expiresAt = state.ExpiresAt
+		f := state.RotationFields()
+		stateFields = &f
+	}
+	renew := keyexpiry.Check(h, "access key", m.Name.ValueString(), m.ExpireTime, m.ExpiresIn, m.RenewBefore, expiresAt, time.Now())
+
+	// a rotated key is a new key with its own id and expiration time
+	fields := m.RotationFields()
+	action := rotation.Plan(h, fields, stateFields, time.Now())
+	if renew {
+		if stateFields == nil || !fields.Enabled(h) {
+			m.ExpiresAt = types.StringUnknown()
+			return path.Paths{path.Root("expires_at")}
+		}
+		action = rotation.ActionRotate // renewed through rotation so the current key remains valid for the overlap duration
+	}
+	fields.Apply(action)
+	if action == rotation.ActionRotate {
+		m.ID = types.StringUnknown()
+		m.ClientID = types.StringUnknown()
+		m.CreatedTime = types.Int64Unknown()
+		m.CreatedBy = types.StringUnknown()
+		m.ExpiresAt = types.StringUnknown()
+	}
+	return nil
+}
+
+func (m *AccessKeyModel) RotationFields() rotation.Fields {
+	return rotation.Fields{Rotation: &m.Rotation, Secret: &m.Cleartext, PreviousSecret: &m.PreviousCleartext, PreviousID: &m.PreviousID, RotatedTime: &m.RotatedTime}
+}
+
 func (m *AccessKeyModel) GetID() stringattr.Type {
 	return m.ID
 }
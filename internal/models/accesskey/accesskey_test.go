@@ -6,6 +6,7 @@ import (
 
 	"github.com/descope/terraform-provider-descope/tools/testacc"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccessKey(t *testing.T) {
@@ -24,6 +25,7 @@ func TestAccessKey(t *testing.T) {
 				"description":     "",
 				"status":          "active",
 				"expire_time":     "0",
+				"expires_at":      "",
 				"roles.#":         "0",
 				"tenants.#":       "0",
 				"permitted_ips.#": "0",
@@ -115,12 +117,89 @@ func TestAccessKey(t *testing.T) {
 				"cleartext":   testacc.AttributeIsSet,
 			}),
 		},
+		// Test relative expiration time with automatic renewal
+		resource.TestStep{
+			Config: p.Config() + a.Config(`
+				project_id = `+p.Path()+`.id
+				expires_in = "90 days"
+				renew_before = "2 weeks"
+			`),
+			Check: a.Check(map[string]any{
+				"expire_time":  "0",
+				"expires_in":   "90 days",
+				"renew_before": "2 weeks",
+				"expires_at":   testacc.AttributeIsSet,
+				"cleartext":    testacc.AttributeIsSet,
+			}),
+		},
+		// Test renew_before requires expires_in
+		resource.TestStep{
+			Config: p.Config() + a.Config(`
+				project_id = `+p.Path()+`.id
+				expire_time = 1924991999
+				renew_before = "2 weeks"
+			`),
+			ExpectError: regexp.MustCompile(`can only be used together with the expires_in attribute`),
+		},
+		// Test rotation settings
+		resource.TestStep{
+			Config: p.Config() + a.Config(`
+				project_id = `+p.Path()+`.id
+				rotation = {
+					keepers = { version = "1" }
+				}
+			`),
+			Check: a.Check(map[string]any{
+				"expires_in":         testacc.AttributeIsNotSet,
+				"renew_before":       testacc.AttributeIsNotSet,
+				"rotation.overlap":   "1 day",
+				"previous_id":        "",
+				"previous_cleartext": "",
+				"rotated_time":       testacc.AttributeIsSet,
+			}),
+		},
+		// Test rotation when the keepers change
+		resource.TestStep{
+			Config: p.Config() + a.Config(`
+				project_id = `+p.Path()+`.id
+				rotation = {
+					keepers = { version = "2" }
+				}
+			`),
+			Check: a.Check(map[string]any{
+				"previous_id":        testacc.AttributeIsSet,
+				"previous_cleartext": testacc.AttributeIsSet,
+				"cleartext":          testacc.AttributeIsSet,
+			}),
+		},
 		// Test import with composite ID
 		resource.TestStep{
 			ResourceName:      a.Path(),
 			ImportState:       true,
 			ImportStateIdFunc: testacc.GenerateImportStateID(a.Path(), "project_id", "id"),
 		},
+		// Test import by name lookup
+		resource.TestStep{
+			ResourceName:      a.Path(),
+			ImportState:       true,
+			ImportStateIdFunc: testacc.GenerateImportStateLookupID(a.Path(), "name", "name"),
+		},
+		// Test import by name lookup with a non-existent name
+		resource.TestStep{
+			ResourceName: a.Path(),
+			ImportState:  true,
+			ImportStateIdFunc: func(state *terraform.State) (string, error) {
+				projectID, err := testacc.GenerateImportStateID(a.Path(), "project_id")(state)
+				return projectID + "/name:does-not-exist", err
+			},
+			ExpectError: regexp.MustCompile(`No access key found with name`),
+		},
+		// Test import with resource identity
+		resource.TestStep{
+			ResourceName:    a.Path(),
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
 		// Destroy resource
 		resource.TestStep{
 			Config:  p.Config() + a.Config(`project_id = `+p.Path()+`.id`),
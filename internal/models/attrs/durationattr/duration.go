@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,12 +28,24 @@ func Required(validators ...validator.String) schema.StringAttribute {
 	}
 }
 
-func Optional(validators ...validator.String) schema.StringAttribute {
+func Optional(extras ...any) schema.StringAttribute {
+	validators, modifiers := parseExtras(extras)
 	return schema.StringAttribute{
 		Optional:      true,
 		Computed:      true,
 		Validators:    append([]validator.String{formatValidator}, validators...),
-		PlanModifiers: []planmodifier.String{helpers.UseValidStateForUnknown()},
+		PlanModifiers: append([]planmodifier.String{helpers.UseValidStateForUnknown()}, modifiers...),
+	}
+}
+
+// Returns an optional attribute that isn't computed, so its value is null whenever it's
+// not set in the configuration instead of being kept from the state.
+func ConfigOnly(extras ...any) schema.StringAttribute {
+	validators, modifiers := parseExtras(extras)
+	return schema.StringAttribute{
+		Optional:      true,
+		Validators:    append([]validator.String{formatValidator}, validators...),
+		PlanModifiers: modifiers,
 	}
 }
 
@@ -91,6 +104,15 @@ func SetMinutes(s *Type, data map[string]any, key string) {
 	}
 }
 
+// Returns the duration value as a time.Duration, or false if it's not set or not a valid duration.
+func Duration(s Type) (time.Duration, bool) {
+	if s.IsNull() || s.IsUnknown() {
+		return 0, false
+	}
+	seconds, ok := getSeconds(s.ValueString())
+	return time.Duration(seconds) * time.Second, ok
+}
+
 // Utils
 
 var units = []string{"seconds", "minutes", "hours", "days", "weeks"}
@@ -128,3 +150,21 @@ func getNumber(data map[string]any, key string) (n int64, ok bool) {
 	}
 	return
 }
+
+func parseExtras(extras []any) (validators []validator.String, modifiers []planmodifier.String) {
+	for _, e := range extras {
+		matched := false
+		if validator, ok := e.(validator.String); ok {
+			matched = true
+			validators = append(validators, validator)
+		}
+		if modifier, ok := e.(planmodifier.String); ok {
+			matched = true
+			modifiers = append(modifiers, modifier)
+		}
+		if !matched {
+			panic(fmt.Sprintf("unexpected extra value of type %T in duration attribute", e))
+		}
+	}
+	return
+}
@@ -30,8 +30,10 @@ func ModifyMatchingKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, plan
 		for e := range Iterator(state, h) {
 			var existing M = e
 			if planned.GetKey().Equal(existing.GetKey()) {
-				h.Log("Setting ID '%s' for %T named '%s' by matching key", existing.GetID().ValueString(), *planned, planned.GetName().ValueString())
-				planned.SetID(existing.GetID())
+				if planned.GetID().IsUnknown() {
+					h.Log("Setting ID '%s' for %T named '%s' by matching key", existing.GetID().ValueString(), *planned, planned.GetName().ValueString())
+					planned.SetID(existing.GetID())
+				}
 				break
 			}
 		}
@@ -54,7 +56,10 @@ func ModifyMatchingNames[T any, M helpers.NamedModel[T]](h *helpers.Handler, pla
 		for p := range MutatingIterator(plan, h) {
 			var planned M = p
 			if planned.GetName().Equal(existing.GetName()) {
-				planned.SetID(existing.GetID())
+				// don't override an identifier that was explicitly set in the plan
+				if planned.GetID().IsUnknown() {
+					planned.SetID(existing.GetID())
+				}
 				matched = true
 				break
 			}
@@ -127,3 +132,20 @@ func SetMatchingNames[T any, M helpers.NamedModel[T]](l *Type[T], data map[strin
 
 	*l = valueOf(h.Ctx, elements)
 }
+
+// Counts the keys of the model objects in the list, returning false if only some of the model objects have a key.
+func CollectKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, keys map[string]int, l Type[T]) bool {
+	count, missing := 0, 0
+	for e := range Iterator(l, h) {
+		var element M = e
+		if key := element.GetKey(); key.IsUnknown() {
+			return true // skip validation if there are unknown values
+		} else if v := key.ValueString(); v != "" {
+			keys[v] += 1
+			count += 1
+		} else {
+			missing += 1
+		}
+	}
+	return count == 0 || missing == 0
+}
@@ -13,6 +13,12 @@ func NewModifierMatchingNames[T any, M helpers.NamedModel[T]](description string
 	return &modifierMatchingNames[T, M]{description: description}
 }
 
+// Creates a new modifier that matches entities in the list by key if all existing entities have one, otherwise
+// falls back to matching by name like the modifier returned by NewModifierMatchingNames.
+func NewModifierMatchingKeysOrNames[T any, M helpers.KeyedModel[T]](description string) planmodifier.List {
+	return &modifierMatchingKeysOrNames[T, M]{description: description}
+}
+
 // Implementation
 
 type modifierMatchingNames[T any, M helpers.NamedModel[T]] struct {
@@ -28,6 +34,48 @@ func (v *modifierMatchingNames[T, M]) MarkdownDescription(ctx context.Context) s
 }
 
 func (v *modifierMatchingNames[T, M]) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	modifyList(ctx, req, resp, matchNames[T, M])
+}
+
+type modifierMatchingKeysOrNames[T any, M helpers.KeyedModel[T]] struct {
+	description string
+}
+
+func (v *modifierMatchingKeysOrNames[T, M]) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v *modifierMatchingKeysOrNames[T, M]) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *modifierMatchingKeysOrNames[T, M]) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	modifyList(ctx, req, resp, func(h *helpers.Handler, plan *Type[T], state Type[T]) {
+		keys := map[string]int{}
+		for p := range Iterator(*plan, h) {
+			var planned M = p
+			if key := planned.GetKey().ValueString(); key != "" {
+				keys[key] += 1
+			}
+		}
+		for k, v := range keys {
+			if v > 1 {
+				h.Error("Keys must be unique", "The key '%s' is used %d times", k, v)
+			}
+		}
+
+		for e := range Iterator(state, h) {
+			var existing M = e
+			if existing.GetKey().ValueString() == "" {
+				matchNames[T, M](h, plan, state)
+				return
+			}
+		}
+		ModifyMatchingKeys[T, M](h, plan, state)
+	})
+}
+
+func modifyList[T any](ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse, match func(h *helpers.Handler, plan *Type[T], state Type[T])) {
 	if req.PlanValue.IsNull() || req.Plan.Raw.IsNull() || req.StateValue.IsNull() || req.State.Raw.IsNull() {
 		return
 	}
@@ -45,7 +93,16 @@ func (v *modifierMatchingNames[T, M]) PlanModifyList(ctx context.Context, req pl
 	}
 
 	h := helpers.NewHandler(ctx, &resp.Diagnostics)
-	for p := range MutatingIterator(&plan, h) {
+	match(h, &plan, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = plan.ListValue
+}
+
+func matchNames[T any, M helpers.NamedModel[T]](h *helpers.Handler, plan *Type[T], state Type[T]) {
+	for p := range MutatingIterator(plan, h) {
 		var planned M = p
 		for e := range Iterator(state, h) {
 			var existing M = e
@@ -59,6 +116,4 @@ func (v *modifierMatchingNames[T, M]) PlanModifyList(ctx context.Context, req pl
 			h.Log("No existing ID found for %T named '%s'", *planned, planned.GetName().ValueString())
 		}
 	}
-
-	resp.PlanValue = plan.ListValue
 }
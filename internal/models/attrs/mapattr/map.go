@@ -32,48 +32,53 @@ func valueOf[T any](ctx context.Context, value map[string]*T) Type[T] {
 }
 
 func Required[T any](attributes map[string]schema.Attribute, extras ...any) schema.MapNestedAttribute {
-	mapValidators, objectValidators := parseExtras(extras)
+	mapValidators, objectValidators, mapModifiers, objectModifiers := parseExtras(extras)
 	nested := schema.NestedAttributeObject{
-		Attributes: attributes,
-		Validators: objectValidators,
+		Attributes:    attributes,
+		Validators:    objectValidators,
+		PlanModifiers: objectModifiers,
 	}
 	return schema.MapNestedAttribute{
-		Required:     true,
-		NestedObject: nested,
-		CustomType:   maptype.NewType[T](context.Background()),
-		Validators:   mapValidators,
+		Required:      true,
+		NestedObject:  nested,
+		CustomType:    maptype.NewType[T](context.Background()),
+		Validators:    mapValidators,
+		PlanModifiers: mapModifiers,
 	}
 }
 
 func Optional[T any](attributes map[string]schema.Attribute, extras ...any) schema.MapNestedAttribute {
-	mapValidators, objectValidators := parseExtras(extras)
+	mapValidators, objectValidators, mapModifiers, objectModifiers := parseExtras(extras)
 	nested := schema.NestedAttributeObject{
-		Attributes: attributes,
-		Validators: objectValidators,
+		Attributes:    attributes,
+		Validators:    objectValidators,
+		PlanModifiers: objectModifiers,
 	}
 	return schema.MapNestedAttribute{
 		Optional:      true,
 		Computed:      true,
 		NestedObject:  nested,
 		CustomType:    maptype.NewType[T](context.Background()),
-		PlanModifiers: []planmodifier.Map{helpers.UseValidStateForUnknown()},
+		PlanModifiers: append([]planmodifier.Map{helpers.UseValidStateForUnknown()}, mapModifiers...),
 		Validators:    mapValidators,
 	}
 }
 
 func Default[T any](values map[string]*T, attributes map[string]schema.Attribute, extras ...any) schema.MapNestedAttribute {
-	mapValidators, objectValidators := parseExtras(extras)
+	mapValidators, objectValidators, mapModifiers, objectModifiers := parseExtras(extras)
 	nested := schema.NestedAttributeObject{
-		Attributes: attributes,
-		Validators: objectValidators,
+		Attributes:    attributes,
+		Validators:    objectValidators,
+		PlanModifiers: objectModifiers,
 	}
 	return schema.MapNestedAttribute{
-		Optional:     true,
-		Computed:     true,
-		NestedObject: nested,
-		CustomType:   maptype.NewType[T](context.Background()),
-		Default:      mapdefault.StaticValue(Value(values).MapValue),
-		Validators:   mapValidators,
+		Optional:      true,
+		Computed:      true,
+		NestedObject:  nested,
+		CustomType:    maptype.NewType[T](context.Background()),
+		Default:       mapdefault.StaticValue(Value(values).MapValue),
+		Validators:    mapValidators,
+		PlanModifiers: mapModifiers,
 	}
 }
 
@@ -191,19 +196,19 @@ func MutatingIterator[T any](m *Type[T], h *helpers.Handler) iter.Seq2[string, *
 	}
 }
 
-func parseExtras(extras []any) (mapValidators []validator.Map, objectValidators []validator.Object) {
+func parseExtras(extras []any) (mapValidators []validator.Map, objectValidators []validator.Object, mapModifiers []planmodifier.Map, objectModifiers []planmodifier.Object) {
 	for _, e := range extras {
-		matched := false
-		if v, ok := e.(validator.Map); ok {
-			matched = true
+		switch v := e.(type) {
+		case validator.Map:
 			mapValidators = append(mapValidators, v)
-		}
-		if v, ok := e.(validator.Object); ok {
-			matched = true
+		case validator.Object:
 			objectValidators = append(objectValidators, v)
-		}
-		if !matched {
-			panic(fmt.Sprintf("unexpected extra value of type %T in attribute", e))
+		case planmodifier.Map:
+			mapModifiers = append(mapModifiers, v)
+		case planmodifier.Object:
+			objectModifiers = append(objectModifiers, v)
+		default:
+			panic(fmt.Sprintf("unexpected extra value of type %T in map attribute", e))
 		}
 	}
 	return
@@ -27,7 +27,8 @@ func valueOf[T any](ctx context.Context, values []*T) Type[T] {
 	return helpers.Require(settype.NewValue(ctx, values))
 }
 
-// Deprecated: The set type is buggy, use a list instead.
+// Only use a required set when all the nested attributes are required as well, the set type
+// is buggy when its elements have computed values.
 func Required[T any](attributes map[string]schema.Attribute, validators ...validator.Object) schema.SetNestedAttribute {
 	nested := schema.NestedAttributeObject{
 		Attributes: attributes,
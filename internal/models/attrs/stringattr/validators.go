@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/text/language"
 )
 
 var TimeUnitValidator = stringvalidator.OneOf("seconds", "minutes", "hours", "days", "weeks")
@@ -26,6 +27,8 @@ var NonEmptyValidator validator.String = &nonEmptyValidator{}
 
 var EmailValidator validator.String = &emailValidator{}
 
+var LocaleValidator validator.String = &localeValidator{}
+
 func JSONValidator(required ...string) validator.String {
 	return &jsonValidator{required: required}
 }
@@ -120,3 +123,32 @@ func (v emailValidator) ValidateString(ctx context.Context, req validator.String
 		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(req.Path, "Invalid Email Address", fmt.Sprintf("Attribute %s must be a valid email address", req.Path)))
 	}
 }
+
+// Locale
+
+type localeValidator struct{}
+
+func (v localeValidator) Description(_ context.Context) string {
+	return "must be a valid BCP-47 language tag"
+}
+
+func (v localeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v localeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	tflog.Trace(ctx, "Validating string", map[string]any{"path": req.Path.String()})
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if len(value) == 0 {
+		return
+	}
+	tag, err := language.Parse(value)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(req.Path, "Invalid Locale", fmt.Sprintf("Attribute %s must be a valid BCP-47 language tag, got '%s'", req.Path, value)))
+	} else if tag.String() != value {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(req.Path, "Invalid Locale", fmt.Sprintf("Attribute %s should be written as '%s' instead of '%s'", req.Path, tag.String(), value)))
+	}
+}
@@ -75,6 +75,12 @@ func TestDescoper(t *testing.T) {
 			ResourceName: d.Path(),
 			ImportState:  true,
 		},
+		// Test import by email lookup
+		resource.TestStep{
+			ResourceName:      d.Path(),
+			ImportState:       true,
+			ImportStateIdFunc: testacc.GenerateImportStateLookupID(d.Path(), "email", "email"),
+		},
 		// Test name update
 		resource.TestStep{
 			PreConfig: func() {
@@ -1,9 +1,14 @@
 package engine
 
 import (
+	"time"
+
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
@@ -13,7 +18,9 @@ var EngineAttributes = map[string]schema.Attribute{
 	"project_id":   stringattr.Required(stringplanmodifier.RequiresReplace()),
 	"name":         stringattr.Required(),
 	"created_time": intattr.Generated(),
-	"secret":       stringattr.SecretGenerated(false), // returned only on create; kept in state afterwards
+	"secret":       stringattr.SecretGenerated(false), // returned only on create or rotation; kept in state afterwards
+	"rotation":     rotation.SecretAttribute(),
+	"rotated_time": intattr.Generated(),
 }
 
 var Schema = schema.Schema{
@@ -21,11 +28,13 @@ var Schema = schema.Schema{
 }
 
 type EngineModel struct {
-	ID          stringattr.Type `tfsdk:"id"`
-	ProjectID   stringattr.Type `tfsdk:"project_id"`
-	Name        stringattr.Type `tfsdk:"name"`
-	CreatedTime intattr.Type    `tfsdk:"created_time"`
-	Secret      stringattr.Type `tfsdk:"secret"`
+	ID          stringattr.Type                            `tfsdk:"id"`
+	ProjectID   stringattr.Type                            `tfsdk:"project_id"`
+	Name        stringattr.Type                            `tfsdk:"name"`
+	CreatedTime intattr.Type                               `tfsdk:"created_time"`
+	Secret      stringattr.Type                            `tfsdk:"secret"`
+	Rotation    objattr.Type[rotation.SecretRotationModel] `tfsdk:"rotation"`
+	RotatedTime intattr.Type                               `tfsdk:"rotated_time"`
 }
 
 func (m *EngineModel) Values(h *helpers.Handler) map[string]any {
@@ -40,6 +49,21 @@ func (m *EngineModel) SetValues(h *helpers.Handler, data map[string]any) {
 	stringattr.Set(&m.Secret, data, "secret") // absent on read/update, so the create-time value is kept
 }
 
+func (m *EngineModel) ModifyPlan(h *helpers.Handler, state *EngineModel) path.Paths {
+	var stateFields *rotation.Fields
+	if state != nil {
+		f := state.RotationFields()
+		stateFields = &f
+	}
+	fields := m.RotationFields()
+	fields.Apply(rotation.Plan(h, fields, stateFields, time.Now()))
+	return nil
+}
+
+func (m *EngineModel) RotationFields() rotation.Fields {
+	return rotation.Fields{SecretRotation: &m.Rotation, Secret: &m.Secret, RotatedTime: &m.RotatedTime}
+}
+
 func (m *EngineModel) GetID() stringattr.Type {
 	return m.ID
 }
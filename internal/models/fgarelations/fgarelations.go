@@ -0,0 +1,157 @@
+package fgarelations
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/setattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/authorization/fga"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var FGARelationsAttributes = map[string]schema.Attribute{
+	"id":         stringattr.Identifier(),
+	"project_id": stringattr.Required(stringplanmodifier.RequiresReplace()),
+	"relations":  setattr.Required[RelationModel](RelationAttributes),
+}
+
+const idSeparator = ","
+
+var Schema = schema.Schema{
+	Attributes: FGARelationsAttributes,
+}
+
+// The relation tuples are managed as a group whose id is the sorted list of the tuples in their
+// key notation, so the relations can be checked when the resource is read and imported by
+// passing the same list as the id.
+type FGARelationsModel struct {
+	ID        stringattr.Type             `tfsdk:"id"`
+	ProjectID stringattr.Type             `tfsdk:"project_id"`
+	Relations setattr.Type[RelationModel] `tfsdk:"relations"`
+}
+
+// Returns the payload for creating or checking all the relations in the resource.
+func (m *FGARelationsModel) Values(h *helpers.Handler) map[string]any {
+	data := map[string]any{}
+	setattr.Get(m.Relations, data, "tuples", h)
+	return data
+}
+
+// Sets the relations that still hold in the backend from the response of a check request, which
+// has a result with the allowed value of each tuple that was checked.
+func (m *FGARelationsModel) SetValues(h *helpers.Handler, data map[string]any) {
+	allowed := map[string]bool{}
+	results, _ := data["tuples"].([]any)
+	for _, v := range results {
+		result, _ := v.(map[string]any)
+		if value, ok := result["tuple"].(map[string]any); ok {
+			relation := &RelationModel{}
+			relation.SetValues(h, value)
+			allowed[relation.Key()] = result["allowed"] == true
+		}
+	}
+
+	relations := []*RelationModel{}
+	for r := range setattr.Iterator(m.Relations, h) {
+		if allowed[r.Key()] {
+			relations = append(relations, r)
+		}
+	}
+	m.Relations = setattr.Value(relations)
+}
+
+// Sets the relations from the id when the resource is imported.
+func (m *FGARelationsModel) SetRelationsFromID(h *helpers.Handler) {
+	relations := []*RelationModel{}
+	for key := range strings.SplitSeq(m.ID.ValueString(), idSeparator) {
+		relation, ok := ParseRelationKey(key)
+		if !ok {
+			h.Error("Invalid FGA relations ID", "The relation '%s' in the ID isn't in the 'type:resource#relation@type:target' notation", key)
+			return
+		}
+		relations = append(relations, relation)
+	}
+	m.Relations = setattr.Value(relations)
+}
+
+func (m *FGARelationsModel) ModifyPlan(h *helpers.Handler, state *FGARelationsModel) path.Paths {
+	if m.Relations.IsUnknown() {
+		m.ID = types.StringUnknown()
+		return nil
+	}
+
+	if state == nil || m.MakeID(h) != state.ID.ValueString() {
+		m.ID = types.StringUnknown()
+	}
+	return nil
+}
+
+// Returns the relations that were added and removed in the plan compared to the state, which
+// are sent to the backend instead of the entire list of relations.
+func (m *FGARelationsModel) Changes(h *helpers.Handler, state *FGARelationsModel) (create []any, remove []any) {
+	planned, current := m.index(h), state.index(h)
+	for r := range setattr.Iterator(m.Relations, h) {
+		if current[r.Key()] == nil {
+			create = append(create, r.Values(h))
+		}
+	}
+	for r := range setattr.Iterator(state.Relations, h) {
+		if planned[r.Key()] == nil {
+			remove = append(remove, r.Values(h))
+		}
+	}
+	return create, remove
+}
+
+// Returns the tuples of all the relations in the resource.
+func (m *FGARelationsModel) Tuples(h *helpers.Handler) []any {
+	tuples, _ := m.Values(h)["tuples"].([]any)
+	return tuples
+}
+
+// Returns the id of the group of relations, which is the sorted list of their keys.
+func (m *FGARelationsModel) MakeID(h *helpers.Handler) string {
+	keys := slices.Sorted(maps.Keys(m.index(h)))
+	return strings.Join(keys, idSeparator)
+}
+
+// Validates the relations against the FGA schema of the project, returning an error message
+// for each relation that can't be created with the schema.
+func (m *FGARelationsModel) Check(h *helpers.Handler, schema *fga.Schema) []string {
+	failures := []string{}
+	for r := range setattr.Iterator(m.Relations, h) {
+		if helpers.HasUnknownValues(r.ResourceType, r.Relation, r.TargetType, r.Target) {
+			continue
+		}
+		if err := schema.ValidateRelation(r.ResourceType.ValueString(), r.Relation.ValueString(), r.TargetType.ValueString(), r.Target.ValueString()); err != nil {
+			failures = append(failures, "The relation '"+r.Key()+"' is invalid: "+err.Error())
+		}
+	}
+	return failures
+}
+
+func (m *FGARelationsModel) index(h *helpers.Handler) map[string]*RelationModel {
+	index := map[string]*RelationModel{}
+	for r := range setattr.Iterator(m.Relations, h) {
+		index[r.Key()] = r
+	}
+	return index
+}
+
+func (m *FGARelationsModel) GetID() stringattr.Type {
+	return m.ID
+}
+
+func (m *FGARelationsModel) SetID(id stringattr.Type) {
+	m.ID = id
+}
+
+func (m *FGARelationsModel) GetProjectID() stringattr.Type {
+	return m.ProjectID
+}
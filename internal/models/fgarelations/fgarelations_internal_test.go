@@ -0,0 +1,73 @@
+package fgarelations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/setattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelationKey(t *testing.T) {
+	for _, key := range []string{"org:acme#admin@group:platform#member", "org:acme#viewer@user:*", "doc:a#b#owner@user:jane@example.com"} {
+		relation, ok := ParseRelationKey(key)
+		require.True(t, ok, key)
+		assert.Equal(t, key, relation.Key())
+	}
+
+	relation, _ := ParseRelationKey("doc:a#b#owner@user:jane@example.com")
+	assert.Equal(t, "a#b", relation.Resource.ValueString())
+	assert.Equal(t, "owner", relation.Relation.ValueString())
+	assert.Equal(t, "jane@example.com", relation.Target.ValueString())
+
+	for _, key := range []string{"", "org", "org:acme#admin", "org:acme@user:alice", "org:acme#@user:alice", ":acme#admin@user:alice", "org:acme#admin@user:", "org:acme#admin@user"} {
+		_, ok := ParseRelationKey(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestRelationsFromCheck(t *testing.T) {
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	m := &FGARelationsModel{ID: stringattr.Value("org:acme#viewer@user:*,group:platform#member@user:alice")}
+	m.SetRelationsFromID(h)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "group:platform#member@user:alice,org:acme#viewer@user:*", m.MakeID(h))
+	assert.Len(t, m.Tuples(h), 2)
+
+	// only the relations that still hold are kept
+	m.SetValues(h, map[string]any{"tuples": []any{
+		map[string]any{"allowed": false, "tuple": map[string]any{"resourceType": "group", "resource": "platform", "relation": "member", "targetType": "user", "target": "alice"}},
+		map[string]any{"allowed": true, "tuple": map[string]any{"resourceType": "org", "resource": "acme", "relation": "viewer", "targetType": "user", "target": "*"}},
+	}})
+	assert.Equal(t, "org:acme#viewer@user:*", m.MakeID(h))
+
+	m = &FGARelationsModel{ID: stringattr.Value("org:acme#viewer@user:*,group")}
+	m.SetRelationsFromID(h)
+	assert.True(t, diags.HasError())
+}
+
+func TestRelationChanges(t *testing.T) {
+	h := helpers.NewHandler(context.Background(), &diag.Diagnostics{})
+	relations := func(keys ...string) *FGARelationsModel {
+		result := []*RelationModel{}
+		for _, key := range keys {
+			r, _ := ParseRelationKey(key)
+			result = append(result, r)
+		}
+		return &FGARelationsModel{Relations: setattr.Value(result)}
+	}
+
+	create, remove := relations("org:acme#admin@user:alice", "org:acme#admin@user:bob").Changes(h, relations("org:acme#admin@user:alice", "org:acme#admin@user:carol"))
+	assert.Equal(t, []any{map[string]any{"resourceType": "org", "resource": "acme", "relation": "admin", "targetType": "user", "target": "bob"}}, create)
+	assert.Equal(t, []any{map[string]any{"resourceType": "org", "resource": "acme", "relation": "admin", "targetType": "user", "target": "carol"}}, remove)
+
+	create, remove = relations("org:acme#admin@user:alice").Changes(h, relations("org:acme#admin@user:alice"))
+	assert.Empty(t, create)
+	assert.Empty(t, remove)
+}
@@ -0,0 +1,103 @@
+package fgarelations_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/tools/testacc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const schema = `model AuthZ 1.0\n\ntype user\n\ntype group\n  relation member: user\n\ntype org\n  relation admin: group#member\n  relation viewer: user:*\n`
+
+func TestFGARelations(t *testing.T) {
+	p := testacc.Project(t)
+	r := testacc.FGARelations(t)
+
+	// the relations resource doesn't have a name attribute so its config is written inline
+	config := func(relations string) string {
+		return p.Config(`
+			authorization = {
+				fga = "`+schema+`"
+			}
+		`) + fmt.Sprintf(`
+			resource "descope_fga_relations" "%s" {
+				project_id = %s.id
+				relations = [%s]
+			}
+		`, r.ID, p.Path(), relations)
+	}
+
+	testacc.Run(t,
+		resource.TestStep{
+			Config: config(`
+				{
+					resource_type = "org"
+					resource = "acme"
+					relation = "owner"
+					target_type = "user"
+					target = "alice"
+				}
+			`),
+			ExpectError: regexp.MustCompile(`doesn't define a relation named 'owner'`),
+		},
+		resource.TestStep{
+			Config: config(`
+				{
+					resource_type = "org"
+					resource = "acme"
+					relation = "admin"
+					target_type = "group"
+					target = "platform#member"
+				},
+			`),
+			Check: r.Check(map[string]any{
+				"id":          "org:acme#admin@group:platform#member",
+				"relations.#": 1,
+			}, resource.TestCheckTypeSetElemNestedAttrs(r.Path(), "relations.*", map[string]string{
+				"resource_type": "org",
+				"relation":      "admin",
+				"target":        "platform#member",
+			})),
+		},
+		resource.TestStep{
+			Config: config(`
+				{
+					resource_type = "org"
+					resource = "acme"
+					relation = "viewer"
+					target_type = "user"
+					target = "*"
+				},
+				{
+					resource_type = "group"
+					resource = "platform"
+					relation = "member"
+					target_type = "user"
+					target = "alice"
+				},
+			`),
+			Check: r.Check(map[string]any{
+				"id":          "group:platform#member@user:alice,org:acme#viewer@user:*",
+				"relations.#": 2,
+			}, resource.TestCheckTypeSetElemNestedAttrs(r.Path(), "relations.*", map[string]string{
+				"resource_type": "org",
+				"relation":      "viewer",
+				"target":        "*",
+			}), resource.TestCheckTypeSetElemNestedAttrs(r.Path(), "relations.*", map[string]string{
+				"resource_type": "group",
+				"relation":      "member",
+				"target":        "alice",
+			})),
+		},
+		resource.TestStep{
+			ResourceName:      r.Path(),
+			ImportState:       true,
+			ImportStateIdFunc: testacc.GenerateImportStateID(r.Path(), "project_id", "id"),
+			ImportStateVerify: true,
+			// the imported relations are in the order of their keys in the id
+			ImportStateVerifyIgnore: []string{"relations"},
+		},
+	)
+}
@@ -0,0 +1,69 @@
+package fgarelations
+
+import (
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var RelationAttributes = map[string]schema.Attribute{
+	"resource_type": stringattr.Required(stringattr.NonEmptyValidator),
+	"resource":      stringattr.Required(stringattr.NonEmptyValidator),
+	"relation":      stringattr.Required(stringattr.NonEmptyValidator),
+	"target_type":   stringattr.Required(stringattr.NonEmptyValidator),
+	"target":        stringattr.Required(stringattr.NonEmptyValidator),
+}
+
+type RelationModel struct {
+	ResourceType stringattr.Type `tfsdk:"resource_type"`
+	Resource     stringattr.Type `tfsdk:"resource"`
+	Relation     stringattr.Type `tfsdk:"relation"`
+	TargetType   stringattr.Type `tfsdk:"target_type"`
+	Target       stringattr.Type `tfsdk:"target"`
+}
+
+func (m *RelationModel) Values(h *helpers.Handler) map[string]any {
+	data := map[string]any{}
+	stringattr.Get(m.ResourceType, data, "resourceType")
+	stringattr.Get(m.Resource, data, "resource")
+	stringattr.Get(m.Relation, data, "relation")
+	stringattr.Get(m.TargetType, data, "targetType")
+	stringattr.Get(m.Target, data, "target")
+	return data
+}
+
+func (m *RelationModel) SetValues(h *helpers.Handler, data map[string]any) {
+	stringattr.Set(&m.ResourceType, data, "resourceType")
+	stringattr.Set(&m.Resource, data, "resource")
+	stringattr.Set(&m.Relation, data, "relation")
+	stringattr.Set(&m.TargetType, data, "targetType")
+	stringattr.Set(&m.Target, data, "target")
+}
+
+// Returns the relation in the tuple notation, e.g., 'org:acme#admin@group:platform'.
+func (m *RelationModel) Key() string {
+	return m.ResourceType.ValueString() + ":" + m.Resource.ValueString() + "#" + m.Relation.ValueString() + "@" + m.TargetType.ValueString() + ":" + m.Target.ValueString()
+}
+
+// Parses a relation in the tuple notation that's returned by Key.
+func ParseRelationKey(key string) (*RelationModel, bool) {
+	resource, target, ok := strings.Cut(key, "@")
+	if !ok {
+		return nil, false
+	}
+	resourceType, resource, ok1 := strings.Cut(resource, ":")
+	i := strings.LastIndex(resource, "#")
+	targetType, target, ok2 := strings.Cut(target, ":")
+	if !ok1 || !ok2 || i <= 0 || i == len(resource)-1 || resourceType == "" || targetType == "" || target == "" {
+		return nil, false
+	}
+	return &RelationModel{
+		ResourceType: stringattr.Value(resourceType),
+		Resource:     stringattr.Value(resource[:i]),
+		Relation:     stringattr.Value(resource[i+1:]),
+		TargetType:   stringattr.Value(targetType),
+		Target:       stringattr.Value(target),
+	}, true
+}
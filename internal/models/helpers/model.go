@@ -1,6 +1,9 @@
 package helpers
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 const (
 	DescopeConnector = "Descope"
@@ -47,3 +50,10 @@ type UpdateReferencesModel[T any] interface {
 	Model[T]
 	UpdateReferences(*Handler)
 }
+
+// A resource model that can modify its planned values, where state is nil when the resource is
+// being created. Returns the paths of any attributes whose planned change requires the resource
+// to be replaced.
+type PlanModifierModel[T any] interface {
+	ModifyPlan(h *Handler, state *T) path.Paths
+}
@@ -1,13 +1,19 @@
 package inboundapp
 
 import (
+	"time"
+
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strsetattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -32,6 +38,8 @@ var InboundAppAttributes = map[string]schema.Attribute{
 	"non_confidential_client":          boolattr.Default(false, boolplanmodifier.RequiresReplace()),
 	"client_id":                        stringattr.Optional(stringplanmodifier.RequiresReplace()),
 	"client_secret":                    stringattr.SecretGenerated(true),
+	"rotation":                         rotation.SecretAttribute(objectvalidator.ConflictsWith(path.MatchRoot("client_secret"))),
+	"rotated_time":                     intattr.Generated(),
 	"force_pkce":                       boolattr.Default(false),
 }
 
@@ -40,25 +48,27 @@ var Schema = schema.Schema{
 }
 
 type InboundAppModel struct {
-	ID                           stringattr.Type                      `tfsdk:"id"`
-	ProjectID                    stringattr.Type                      `tfsdk:"project_id"`
-	Name                         stringattr.Type                      `tfsdk:"name"`
-	Description                  stringattr.Type                      `tfsdk:"description"`
-	LogoUrl                      stringattr.Type                      `tfsdk:"logo_url"`
-	LoginPageUrl                 stringattr.Type                      `tfsdk:"login_page_url"`
-	ApprovedCallbackUrls         strsetattr.Type                      `tfsdk:"approved_callback_urls"`
-	PermissionsScopes            listattr.Type[ApplicationScopeModel] `tfsdk:"permissions_scopes"`
-	AttributesScopes             listattr.Type[ApplicationScopeModel] `tfsdk:"attributes_scopes"`
-	ConnectionsScopes            listattr.Type[ApplicationScopeModel] `tfsdk:"connections_scopes"`
-	SessionSettings              objattr.Type[SessionSettingsModel]   `tfsdk:"session_settings"`
-	AudienceWhitelist            strsetattr.Type                      `tfsdk:"audience_whitelist"`
-	ForceAddAllAuthorizationInfo boolattr.Type                        `tfsdk:"force_add_all_authorization_info"`
-	ForceDpop                    boolattr.Type                        `tfsdk:"force_dpop"`
-	DefaultAudience              stringattr.Type                      `tfsdk:"default_audience"`
-	NonConfidentialClient        boolattr.Type                        `tfsdk:"non_confidential_client"`
-	ClientId                     stringattr.Type                      `tfsdk:"client_id"`
-	ClientSecret                 stringattr.Type                      `tfsdk:"client_secret"`
-	ForcePkce                    boolattr.Type                        `tfsdk:"force_pkce"`
+	ID                           stringattr.Type                            `tfsdk:"id"`
+	ProjectID                    stringattr.Type                            `tfsdk:"project_id"`
+	Name                         stringattr.Type                            `tfsdk:"name"`
+	Description                  stringattr.Type                            `tfsdk:"description"`
+	LogoUrl                      stringattr.Type                            `tfsdk:"logo_url"`
+	LoginPageUrl                 stringattr.Type                            `tfsdk:"login_page_url"`
+	ApprovedCallbackUrls         strsetattr.Type                            `tfsdk:"approved_callback_urls"`
+	PermissionsScopes            listattr.Type[ApplicationScopeModel]       `tfsdk:"permissions_scopes"`
+	AttributesScopes             listattr.Type[ApplicationScopeModel]       `tfsdk:"attributes_scopes"`
+	ConnectionsScopes            listattr.Type[ApplicationScopeModel]       `tfsdk:"connections_scopes"`
+	SessionSettings              objattr.Type[SessionSettingsModel]         `tfsdk:"session_settings"`
+	AudienceWhitelist            strsetattr.Type                            `tfsdk:"audience_whitelist"`
+	ForceAddAllAuthorizationInfo boolattr.Type                              `tfsdk:"force_add_all_authorization_info"`
+	ForceDpop                    boolattr.Type                              `tfsdk:"force_dpop"`
+	DefaultAudience              stringattr.Type                            `tfsdk:"default_audience"`
+	NonConfidentialClient        boolattr.Type                              `tfsdk:"non_confidential_client"`
+	ClientId                     stringattr.Type                            `tfsdk:"client_id"`
+	ClientSecret                 stringattr.Type                            `tfsdk:"client_secret"`
+	Rotation                     objattr.Type[rotation.SecretRotationModel] `tfsdk:"rotation"`
+	RotatedTime                  intattr.Type                               `tfsdk:"rotated_time"`
+	ForcePkce                    boolattr.Type                              `tfsdk:"force_pkce"`
 }
 
 func (m *InboundAppModel) Values(h *helpers.Handler) map[string]any {
@@ -103,6 +113,21 @@ func (m *InboundAppModel) SetValues(h *helpers.Handler, data map[string]any) {
 	boolattr.Set(&m.ForcePkce, data, "forcePkce")
 }
 
+func (m *InboundAppModel) ModifyPlan(h *helpers.Handler, state *InboundAppModel) path.Paths {
+	var stateFields *rotation.Fields
+	if state != nil {
+		f := state.RotationFields()
+		stateFields = &f
+	}
+	fields := m.RotationFields()
+	fields.Apply(rotation.Plan(h, fields, stateFields, time.Now()))
+	return nil
+}
+
+func (m *InboundAppModel) RotationFields() rotation.Fields {
+	return rotation.Fields{SecretRotation: &m.Rotation, Secret: &m.ClientSecret, RotatedTime: &m.RotatedTime}
+}
+
 func (m *InboundAppModel) GetID() stringattr.Type {
 	return m.ID
 }
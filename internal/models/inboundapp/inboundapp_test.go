@@ -1,6 +1,7 @@
 package inboundapp_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/descope/terraform-provider-descope/tools/testacc"
@@ -148,6 +149,35 @@ func TestInboundApp(t *testing.T) {
 			ImportState:       true,
 			ImportStateIdFunc: testacc.GenerateImportStateID(a.Path(), "project_id", "id"),
 		},
+		// Test callback URLs in the project's approved domains
+		resource.TestStep{
+			Config: p.Config(`
+				environment = "production"
+				project_settings = {
+					approved_domains = ["example.com"]
+				}
+			`) + a.Config(`
+				project_id = `+p.Path()+`.id
+				approved_callback_urls = ["https://app.example.com/callback"]
+			`),
+			Check: a.Check(map[string]any{
+				"approved_callback_urls.#": "1",
+				"approved_callback_urls.0": "https://app.example.com/callback",
+			}),
+		},
+		// Test callback URLs outside the project's approved domains
+		resource.TestStep{
+			Config: p.Config(`
+				environment = "production"
+				project_settings = {
+					approved_domains = ["example.com"]
+				}
+			`) + a.Config(`
+				project_id = `+p.Path()+`.id
+				approved_callback_urls = ["https://app.exmaple.com/callback"]
+			`),
+			ExpectError: regexp.MustCompile(`approved_callback_urls of the '.+' inbound app attribute is set to the URL`),
+		},
 		// Destroy resource
 		resource.TestStep{
 			Config: p.Config() + a.Config(`
@@ -0,0 +1,146 @@
+package jwtpreview
+
+import (
+	"encoding/json"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/jwttemplates"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var Schema = schema.Schema{
+	MarkdownDescription: "Renders the claims that Descope would add to a JWT created with a JWT template, without " +
+		"making any API calls. This can be used in `check` blocks or Terraform tests to assert the shape of " +
+		"tokens before changes to JWT templates are rolled out.",
+	Attributes: map[string]schema.Attribute{
+		"project_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the project the token is issued by, which is used for the `iss` claim. The `iss` claim is omitted if this isn't set.",
+		},
+		"template": schema.StringAttribute{
+			Required:    true,
+			Description: "The JSON template defining the structure and claims of the JWT token, usually taken from a JWT template in the `descope_project` resource.",
+		},
+		"auth_schema": schema.StringAttribute{
+			Optional:    true,
+			Description: "The authorization claims format, either `default`, `tenantOnly` or `none`. Defaults to `default`.",
+			Validators:  []validator.String{stringvalidator.OneOf("default", "tenantOnly", "none")},
+		},
+		"empty_claim_policy": schema.StringAttribute{
+			Optional:    true,
+			Description: "How claims with empty values are rendered, either `none` for an empty string, `nil` for a null value, or `delete` to remove the claim. Defaults to `none`.",
+			Validators:  []validator.String{stringvalidator.OneOf("none", "nil", "delete")},
+		},
+		"auto_tenant_claim": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the `dct` claim is set when the subject belongs to a single tenant.",
+		},
+		"conformance_issuer": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the `iss` claim is the full issuer URL rather than the project ID.",
+		},
+		"exclude_permission_claim": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the permissions are left out of the authorization claims.",
+		},
+		"override_subject_claim": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the template can set the `sub` claim, in which case the subject ID is moved to the `dsub` claim.",
+		},
+		"add_jti_claim": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the `jti` claim is added. Its value is always `" + jwttemplates.RenderedJTI + "` since the actual value is generated for each token.",
+		},
+		"user": schema.StringAttribute{
+			Optional:    true,
+			Description: "A JSON object with a sample user for user JWT templates, e.g., `jsonencode({ userId = \"U1\", email = \"jane@example.com\", roles = [\"viewer\"], tenants = [{ tenantId = \"T1\", roles = [\"admin\"] }], customAttributes = { plan = \"pro\" } })`.",
+			Validators:  []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("user"), path.MatchRoot("access_key"))},
+		},
+		"access_key": schema.StringAttribute{
+			Optional:    true,
+			Description: "A JSON object with a sample access key for access key JWT templates, with an `id` field and the same `roles`, `permissions` and `tenants` fields as a user.",
+		},
+		"tenant": schema.StringAttribute{
+			Optional:    true,
+			Description: "A JSON object with a sample tenant that `@tenant` dynamic values are taken from, e.g., `jsonencode({ id = \"T1\", name = \"Acme\", customAttributes = { region = \"eu\" } })`.",
+		},
+		"claims": schema.StringAttribute{
+			Computed:    true,
+			Description: "The rendered claims as a JSON object, which can be parsed with `jsondecode`. Claims that depend on the time the token is created, such as `exp` and `iat`, aren't included.",
+		},
+	},
+}
+
+type JWTPreviewModel struct {
+	ProjectID              types.String `tfsdk:"project_id"`
+	Template               types.String `tfsdk:"template"`
+	AuthSchema             types.String `tfsdk:"auth_schema"`
+	EmptyClaimPolicy       types.String `tfsdk:"empty_claim_policy"`
+	AutoDCT                types.Bool   `tfsdk:"auto_tenant_claim"`
+	ConformanceIssuer      types.Bool   `tfsdk:"conformance_issuer"`
+	ExcludePermissionClaim types.Bool   `tfsdk:"exclude_permission_claim"`
+	OverrideSubjectClaim   types.Bool   `tfsdk:"override_subject_claim"`
+	AddJtiClaim            types.Bool   `tfsdk:"add_jti_claim"`
+	User                   types.String `tfsdk:"user"`
+	AccessKey              types.String `tfsdk:"access_key"`
+	Tenant                 types.String `tfsdk:"tenant"`
+	Claims                 types.String `tfsdk:"claims"`
+}
+
+// Renders the JWT template with the sample objects and sets the claims attribute.
+func (m *JWTPreviewModel) Render(h *helpers.Handler) {
+	template := &jwttemplates.JWTTemplateModel{
+		Template:               m.Template,
+		AuthSchema:             withDefault(m.AuthSchema, "default"),
+		EmptyClaimPolicy:       withDefault(m.EmptyClaimPolicy, "none"),
+		AutoDCT:                types.BoolValue(m.AutoDCT.ValueBool()),
+		ConformanceIssuer:      types.BoolValue(m.ConformanceIssuer.ValueBool()),
+		ExcludePermissionClaim: types.BoolValue(m.ExcludePermissionClaim.ValueBool()),
+		OverrideSubjectClaim:   types.BoolValue(m.OverrideSubjectClaim.ValueBool()),
+		AddJtiClaim:            types.BoolValue(m.AddJtiClaim.ValueBool()),
+	}
+
+	sample := &jwttemplates.Sample{ProjectID: m.ProjectID.ValueString()}
+	sample.User = parseObject(h, m.User, "user")
+	sample.AccessKey = parseObject(h, m.AccessKey, "access_key")
+	sample.Tenant = parseObject(h, m.Tenant, "tenant")
+	if h.Diagnostics.HasError() {
+		return
+	}
+
+	claims, err := template.Render(sample)
+	if err != nil {
+		h.Error("Invalid JWT Template", "Failed to render the JWT template: %s", err.Error())
+		return
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		h.Error("Invalid JWT Template", "Failed to encode the rendered claims: %s", err.Error())
+		return
+	}
+	m.Claims = types.StringValue(string(b))
+}
+
+func parseObject(h *helpers.Handler, value types.String, attribute string) map[string]any {
+	if value.IsNull() {
+		return nil
+	}
+	object := map[string]any{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &object); err != nil {
+		h.Invalid("The %s attribute must be a valid JSON object: %s", attribute, err.Error())
+		return nil
+	}
+	return object
+}
+
+func withDefault(value types.String, def string) types.String {
+	if value.IsNull() {
+		return types.StringValue(def)
+	}
+	return value
+}
@@ -0,0 +1,49 @@
+package jwtpreview_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/tools/testacc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestJWTPreview(t *testing.T) {
+	testacc.Run(t,
+		resource.TestStep{
+			Config: `
+				data "descope_jwt_preview" "test" {
+					template = "{}"
+				}
+			`,
+			ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+		},
+		resource.TestStep{
+			Config: `
+				data "descope_jwt_preview" "test" {
+					project_id = "P1"
+					template = jsonencode({
+						sub = "@user.email"
+						plan = "@user.customAttributes.plan"
+						exp = 0
+					})
+					override_subject_claim = true
+					exclude_permission_claim = true
+					user = jsonencode({
+						userId = "U1"
+						email = "jane@example.com"
+						roles = ["viewer"]
+						customAttributes = { plan = "pro" }
+					})
+				}
+			`,
+			Check: resource.TestCheckResourceAttrWith("data.descope_jwt_preview.test", "claims", testacc.AttributeMatchesJSON(`{
+				"sub": "jane@example.com",
+				"dsub": "U1",
+				"iss": "P1",
+				"plan": "pro",
+				"roles": ["viewer"]
+			}`)),
+		},
+	)
+}
@@ -0,0 +1,114 @@
+package keyexpiry
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/durationattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// The default window before a key's expiry in which a warning is shown.
+const DefaultWarningWindow = 30 * 24 * time.Hour
+
+// The name of the environment variable that overrides the number of days in the warning window.
+const WarningWindowEnv = "DESCOPE_KEY_EXPIRY_WARNING_DAYS"
+
+// Returns the window before a key's expiry in which a warning is shown, or an error if the
+// environment variable is set to an invalid value.
+func WarningWindow() (time.Duration, error) {
+	v := strings.TrimSpace(os.Getenv(WarningWindowEnv))
+	if v == "" {
+		return DefaultWarningWindow, nil
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("the %s environment variable must be a non-negative number of days, found '%s'", WarningWindowEnv, v)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// Sets the expiration time of a key in the payload, either from the absolute expire_time value
+// or by adding the expires_in duration to the current time. When an existing key is updated
+// its expires_at value is used instead, so the key's expiration time isn't extended.
+func Get(expireTime intattr.Type, expiresIn durationattr.Type, expiresAt stringattr.Type, data map[string]any, key string, now time.Time) {
+	duration, ok := durationattr.Duration(expiresIn)
+	if !ok {
+		intattr.Get(expireTime, data, key)
+		return
+	}
+	if t, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err == nil {
+		data[key] = t.Unix()
+	} else {
+		data[key] = now.Add(duration).Unix()
+	}
+}
+
+// Sets the expire_time and expires_at values from the payload. The expire_time value is only
+// set when expires_in isn't used, as it's meant to hold the value in the configuration.
+func Set(expireTime *intattr.Type, expiresIn durationattr.Type, expiresAt *stringattr.Type, data map[string]any, key string) {
+	if _, ok := durationattr.Duration(expiresIn); ok {
+		if expireTime.IsUnknown() {
+			*expireTime = intattr.Value(0)
+		}
+	} else {
+		intattr.Set(expireTime, data, key)
+	}
+
+	var unix int64
+	if v, ok := data[key].(float64); ok {
+		unix = int64(v)
+	} else if v, ok := data[key].(int64); ok {
+		unix = v
+	}
+	if unix > 0 {
+		*expiresAt = stringattr.Value(time.Unix(unix, 0).UTC().Format(time.RFC3339))
+	} else {
+		*expiresAt = stringattr.Value("")
+	}
+}
+
+// Validates the expiry attributes in the plan of a key, and warns if the existing key has expired
+// or is about to expire. Returns true if the key is within its renew_before duration and should
+// be renewed, either by replacing it or by rotating it when rotation is configured.
+func Check(h *helpers.Handler, kind, name string, expireTime intattr.Type, expiresIn, renewBefore, expiresAt stringattr.Type, now time.Time) bool {
+	lifetime, hasLifetime := durationattr.Duration(expiresIn)
+	if hasLifetime && expireTime.ValueInt64() > 0 {
+		h.Conflict("The expire_time and expires_in attributes cannot both be set")
+	}
+
+	renewal, hasRenewal := durationattr.Duration(renewBefore)
+	if hasRenewal && !hasLifetime && !expiresIn.IsUnknown() {
+		h.Invalid("The renew_before attribute can only be used together with the expires_in attribute")
+	}
+	if hasRenewal && hasLifetime && renewal >= lifetime {
+		h.Invalid("The renew_before attribute must be shorter than the expires_in attribute")
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil || h.Diagnostics.HasError() {
+		return false
+	}
+
+	if hasRenewal && hasLifetime && !now.Before(expiry.Add(-renewal)) {
+		h.Warn("Key Renewal", "The %s '%s' expires at %s and will be renewed, as it's within the renew_before duration of %s", kind, name, expiresAt.ValueString(), renewBefore.ValueString())
+		return true
+	}
+
+	window, err := WarningWindow()
+	if err != nil {
+		h.Log("Using default key expiry warning window: %s", err.Error())
+		window = DefaultWarningWindow
+	}
+	if !now.Before(expiry) {
+		h.Warn("Key Expired", "The %s '%s' expired at %s", kind, name, expiresAt.ValueString())
+	} else if remaining := expiry.Sub(now); remaining <= window {
+		h.Warn("Key Expiring Soon", "The %s '%s' expires at %s, in %d days", kind, name, expiresAt.ValueString(), int(remaining.Hours()/24))
+	}
+	return false
+}
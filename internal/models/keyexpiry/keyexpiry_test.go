@@ -0,0 +1,90 @@
+package keyexpiry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSet(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a new key expires relative to the current time
+	data := map[string]any{}
+	Get(intattr.Value(0), stringattr.Value("90 days"), types.StringUnknown(), data, "expireTime", now)
+	assert.Equal(t, now.Add(90*24*time.Hour).Unix(), data["expireTime"])
+
+	// an existing key keeps its expiration time
+	Get(intattr.Value(0), stringattr.Value("90 days"), stringattr.Value("2026-02-01T00:00:00Z"), data, "expireTime", now)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), data["expireTime"])
+
+	// the absolute expiration time is used when expires_in isn't set
+	Get(intattr.Value(1924991999), stringattr.Value(""), stringattr.Value(""), data, "expireTime", now)
+	assert.Equal(t, int64(1924991999), data["expireTime"])
+
+	// expire_time is only read back when expires_in isn't used
+	expireTime, expiresAt := intattr.Value(0), types.StringUnknown()
+	Set(&expireTime, stringattr.Value("90 days"), &expiresAt, map[string]any{"expireTime": float64(1924991999)}, "expireTime")
+	assert.Equal(t, int64(0), expireTime.ValueInt64())
+	assert.Equal(t, "2030-12-31T23:59:59Z", expiresAt.ValueString())
+
+	Set(&expireTime, types.StringNull(), &expiresAt, map[string]any{"expireTime": float64(0)}, "expireTime")
+	assert.Equal(t, int64(0), expireTime.ValueInt64())
+	assert.Equal(t, "", expiresAt.ValueString())
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t.Setenv(WarningWindowEnv, "")
+
+	// keys that expire outside the warning window aren't reported
+	diags, replace := check(0, "90 days", "", "2026-03-01T00:00:00Z", now)
+	assert.Empty(t, diags)
+	assert.False(t, replace)
+
+	// keys that expire within the warning window or have expired produce warnings
+	diags, replace = check(0, "90 days", "", "2026-01-11T00:00:00Z", now)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Key Expiring Soon", diags[0].Summary())
+	assert.False(t, replace)
+	diags, _ = check(1767139200, "", "", "2025-12-31T00:00:00Z", now)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Key Expired", diags[0].Summary())
+
+	// keys within their renew_before duration are replaced
+	diags, replace = check(0, "90 days", "14 days", "2026-01-11T00:00:00Z", now)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, "Key Renewal", diags[0].Summary())
+	assert.True(t, replace)
+
+	// the warning window can be configured with an environment variable
+	t.Setenv(WarningWindowEnv, "5")
+	diags, _ = check(0, "90 days", "", "2026-01-11T00:00:00Z", now)
+	assert.Empty(t, diags)
+	t.Setenv(WarningWindowEnv, "foo")
+	_, err := WarningWindow()
+	assert.Error(t, err)
+
+	// invalid combinations of attributes are rejected
+	diags, _ = check(1924991999, "90 days", "", "", now)
+	assert.True(t, diags.HasError())
+	diags, _ = check(0, "", "14 days", "", now)
+	assert.True(t, diags.HasError())
+	diags, _ = check(0, "10 days", "2 weeks", "", now)
+	assert.True(t, diags.HasError())
+}
+
+func check(expireTime int64, expiresIn, renewBefore, expiresAt string, now time.Time) (diag.Diagnostics, bool) {
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+	replace := Check(h, "access key", "foo", intattr.Value(expireTime), stringattr.Value(expiresIn), stringattr.Value(renewBefore), stringattr.Value(expiresAt), now)
+	return diags, replace
+}
@@ -0,0 +1,178 @@
+package keymaterial
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The default window before a certificate's expiry in which a warning is shown.
+const DefaultExpiryWindow = 30 * 24 * time.Hour
+
+// The name of the environment variable that overrides the number of days in the expiry window.
+const ExpiryWindowEnv = "DESCOPE_CERTIFICATE_EXPIRY_WARNING_DAYS"
+
+// Returns the window before a certificate's expiry in which a warning is shown, or an
+// error if the environment variable is set to an invalid value.
+func ExpiryWindow() (time.Duration, error) {
+	v := strings.TrimSpace(os.Getenv(ExpiryWindowEnv))
+	if v == "" {
+		return DefaultExpiryWindow, nil
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("the %s environment variable must be a non-negative number of days, found '%s'", ExpiryWindowEnv, v)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// Returns a warning message if the certificate has expired or will expire within the window
+// that starts at the given time, or an empty string otherwise.
+func ExpiryWarning(cert *x509.Certificate, now time.Time, window time.Duration) string {
+	expiry := cert.NotAfter.UTC().Format(time.RFC3339)
+	if !now.Before(cert.NotAfter) {
+		return fmt.Sprintf("The certificate for '%s' expired at %s", cert.Subject.String(), expiry)
+	}
+	if remaining := cert.NotAfter.Sub(now); remaining <= window {
+		return fmt.Sprintf("The certificate for '%s' expires at %s, in %d days", cert.Subject.String(), expiry, int(remaining.Hours()/24))
+	}
+	return ""
+}
+
+// Parses an X.509 certificate either from a PEM block or from the base64 encoded DER bytes
+// without the PEM header and footer, as certificates are often copied from SAML metadata.
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	if err := checkEscapedNewlines(value); err != nil {
+		return nil, err
+	}
+
+	var der []byte
+	if block, rest := pem.Decode([]byte(value)); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected a CERTIFICATE PEM block but found %s", block.Type)
+		}
+		if strings.TrimSpace(string(rest)) != "" {
+			return nil, errors.New("expected a single PEM encoded certificate but found unexpected data after it")
+		}
+		der = block.Bytes
+	} else if strings.Contains(value, "-----BEGIN") {
+		return nil, errors.New("the PEM encoded certificate is malformed, make sure the value has its original line breaks")
+	} else {
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+		if err != nil {
+			return nil, errors.New("expected a PEM encoded certificate or base64 encoded certificate data")
+		}
+		der = b
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("the certificate could not be parsed: %w", err)
+	}
+	return cert, nil
+}
+
+// Parses a PEM encoded private key in PKCS #8, PKCS #1 or SEC 1 format.
+func ParsePrivateKey(value string) (crypto.PrivateKey, error) {
+	if err := checkEscapedNewlines(value); err != nil {
+		return nil, err
+	}
+
+	block, rest := pem.Decode([]byte(value))
+	if block == nil {
+		if strings.Contains(value, "-----BEGIN") {
+			return nil, errors.New("the PEM encoded private key is malformed, make sure the value has its original line breaks")
+		}
+		return nil, errors.New("expected a PEM encoded private key")
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("expected a single PEM encoded private key but found unexpected data after it")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("the private key could not be parsed: %w", err)
+		}
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("the private key could not be parsed: %w", err)
+		}
+		return key, nil
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("the private key could not be parsed: %w", err)
+		}
+		return key, nil
+	case "ENCRYPTED PRIVATE KEY":
+		return nil, errors.New("the private key must not be encrypted")
+	default:
+		return nil, fmt.Errorf("expected a PRIVATE KEY PEM block but found %s", block.Type)
+	}
+}
+
+// Parses a PEM encoded private key and ensures it's an ECDSA key on the P-256 curve, as
+// required for signing with the ES256 algorithm.
+func ParseP256PrivateKey(value string) (*ecdsa.PrivateKey, error) {
+	key, err := ParsePrivateKey(value)
+	if err != nil {
+		return nil, err
+	}
+	ec, ok := key.(*ecdsa.PrivateKey)
+	if !ok || ec.Curve != elliptic.P256() {
+		return nil, errors.New("expected an ECDSA private key on the P-256 curve")
+	}
+	return ec, nil
+}
+
+// The fields in a Google Cloud service account key file that are needed for authentication.
+type ServiceAccount struct {
+	Type        string `json:"type"`
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// Parses a Google Cloud service account key file, as used by Firebase Admin and other
+// Google Cloud connectors, and ensures its private key is valid.
+func ParseServiceAccount(value string) (*ServiceAccount, error) {
+	var sa ServiceAccount
+	if err := json.Unmarshal([]byte(value), &sa); err != nil {
+		return nil, fmt.Errorf("expected the service account key file JSON: %w", err)
+	}
+	if sa.Type != "service_account" {
+		return nil, fmt.Errorf("expected the JSON type field to be 'service_account' but found '%s'", sa.Type)
+	}
+	if sa.ClientEmail == "" {
+		return nil, errors.New("the service account JSON is missing the client_email field")
+	}
+	if sa.PrivateKey == "" {
+		return nil, errors.New("the service account JSON is missing the private_key field")
+	}
+	if _, err := ParsePrivateKey(sa.PrivateKey); err != nil {
+		return nil, fmt.Errorf("the service account private_key field is invalid: %w", err)
+	}
+	return &sa, nil
+}
+
+// Catches the common mistake of pasting a PEM value with literal \n sequences instead of line breaks.
+func checkEscapedNewlines(value string) error {
+	if strings.Contains(value, "-----BEGIN") && !strings.Contains(value, "\n") && strings.Contains(value, `\n`) {
+		return errors.New(`the PEM value has escaped \n sequences instead of line breaks`)
+	}
+	return nil
+}
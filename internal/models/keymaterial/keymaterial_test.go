@@ -0,0 +1,177 @@
+package keymaterial
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCertificate(t *testing.T) {
+	der := testCertificate(t, time.Now().Add(365*24*time.Hour))
+	value := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	// both PEM and bare base64 values are accepted
+	cert, err := ParseCertificate(value)
+	require.NoError(t, err)
+	assert.Equal(t, der, cert.Raw)
+	cert, err = ParseCertificate(base64.StdEncoding.EncodeToString(der))
+	require.NoError(t, err)
+	assert.Equal(t, der, cert.Raw)
+
+	// values with broken newlines are rejected with a hint
+	_, err = ParseCertificate(strings.ReplaceAll(value, "\n", `\n`))
+	assert.ErrorContains(t, err, `escaped \n sequences`)
+	_, err = ParseCertificate(strings.ReplaceAll(value, "\n", " "))
+	assert.ErrorContains(t, err, "original line breaks")
+
+	// other malformed values are rejected
+	_, err = ParseCertificate("foo")
+	assert.ErrorContains(t, err, "expected a PEM encoded certificate")
+	_, err = ParseCertificate(base64.StdEncoding.EncodeToString([]byte("foo")))
+	assert.ErrorContains(t, err, "could not be parsed")
+	_, err = ParseCertificate(testPrivateKey(t))
+	assert.ErrorContains(t, err, "expected a CERTIFICATE PEM block")
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	value := testPrivateKey(t)
+	key, err := ParsePrivateKey(value)
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PrivateKey{}, key)
+	_, err = ParseP256PrivateKey(value)
+	require.NoError(t, err)
+
+	// RSA keys are valid private keys but not for ES256
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	rsaValue := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	_, err = ParsePrivateKey(rsaValue)
+	require.NoError(t, err)
+	_, err = ParseP256PrivateKey(rsaValue)
+	assert.ErrorContains(t, err, "P-256")
+
+	// malformed values are rejected
+	_, err = ParsePrivateKey(strings.ReplaceAll(value, "\n", `\n`))
+	assert.ErrorContains(t, err, `escaped \n sequences`)
+	_, err = ParsePrivateKey("foo")
+	assert.ErrorContains(t, err, "expected a PEM encoded private key")
+	_, err = ParsePrivateKey(value + value)
+	assert.ErrorContains(t, err, "unexpected data")
+	_, err = ParsePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte("foo")})))
+	assert.ErrorContains(t, err, "must not be encrypted")
+}
+
+func TestParseServiceAccount(t *testing.T) {
+	data := map[string]any{
+		"type":         "service_account",
+		"project_id":   "foo",
+		"client_email": "foo@foo.iam.gserviceaccount.com",
+		"private_key":  testPrivateKey(t),
+	}
+	sa, err := ParseServiceAccount(testJSON(t, data))
+	require.NoError(t, err)
+	assert.Equal(t, "foo@foo.iam.gserviceaccount.com", sa.ClientEmail)
+
+	_, err = ParseServiceAccount("foo")
+	assert.ErrorContains(t, err, "service account key file JSON")
+
+	data["private_key"] = strings.ReplaceAll(testPrivateKey(t), "\n", "")
+	_, err = ParseServiceAccount(testJSON(t, data))
+	assert.ErrorContains(t, err, "private_key field is invalid")
+
+	delete(data, "client_email")
+	_, err = ParseServiceAccount(testJSON(t, data))
+	assert.ErrorContains(t, err, "client_email")
+
+	data["type"] = "authorized_user"
+	_, err = ParseServiceAccount(testJSON(t, data))
+	assert.ErrorContains(t, err, "'service_account'")
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	now := time.Now()
+
+	expiring, err := x509.ParseCertificate(testCertificate(t, now.Add(10*24*time.Hour)))
+	require.NoError(t, err)
+	assert.Contains(t, ExpiryWarning(expiring, now, DefaultExpiryWindow), "expires at")
+	assert.Empty(t, ExpiryWarning(expiring, now, 5*24*time.Hour))
+
+	expired, err := x509.ParseCertificate(testCertificate(t, now.Add(-time.Hour)))
+	require.NoError(t, err)
+	assert.Contains(t, ExpiryWarning(expired, now, 0), "expired at")
+
+	// the window can be configured with an environment variable
+	t.Setenv(ExpiryWindowEnv, "")
+	window, err := ExpiryWindow()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultExpiryWindow, window)
+	t.Setenv(ExpiryWindowEnv, "5")
+	window, err = ExpiryWindow()
+	require.NoError(t, err)
+	assert.Equal(t, 5*24*time.Hour, window)
+	t.Setenv(ExpiryWindowEnv, "foo")
+	_, err = ExpiryWindow()
+	assert.Error(t, err)
+
+	// the validator produces a warning for an expiring certificate and an error for an invalid one
+	t.Setenv(ExpiryWindowEnv, "")
+	resp := validateString(t, CertificateValidator, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: expiring.Raw})))
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	resp = validateString(t, CertificateValidator, "foo")
+	assert.True(t, resp.Diagnostics.HasError())
+	resp = validateString(t, CertificateValidator, "")
+	assert.Empty(t, resp.Diagnostics)
+}
+
+func validateString(t *testing.T, v validator.String, value string) *validator.StringResponse {
+	req := validator.StringRequest{Path: path.Root("value"), ConfigValue: types.StringValue(value)}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), req, resp)
+	return resp
+}
+
+func testCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now().Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
+
+func testPrivateKey(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func testJSON(t *testing.T, data map[string]any) string {
+	b, err := json.Marshal(data)
+	require.NoError(t, err)
+	return string(b)
+}
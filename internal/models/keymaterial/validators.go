@@ -0,0 +1,93 @@
+package keymaterial
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Validates that the attribute is an X.509 certificate and warns if it's about to expire.
+var CertificateValidator validator.String = &materialValidator{
+	description: "must be a valid X.509 certificate",
+	validate:    CheckCertificate,
+}
+
+// Validates that the attribute is a PEM encoded private key.
+var PrivateKeyValidator validator.String = &materialValidator{
+	description: "must be a valid PEM encoded private key",
+	validate: func(_ context.Context, value string) (string, error) {
+		_, err := ParsePrivateKey(value)
+		return "", err
+	},
+}
+
+// Validates that the attribute is a PEM encoded ECDSA P-256 private key, e.g., for Sign in with Apple.
+var P256PrivateKeyValidator validator.String = &materialValidator{
+	description: "must be a valid PEM encoded ECDSA P-256 private key",
+	validate: func(_ context.Context, value string) (string, error) {
+		_, err := ParseP256PrivateKey(value)
+		return "", err
+	},
+}
+
+// Validates that the attribute is a Google Cloud service account key file.
+var ServiceAccountValidator validator.String = &materialValidator{
+	description: "must be a valid Google Cloud service account key file JSON",
+	validate: func(_ context.Context, value string) (string, error) {
+		_, err := ParseServiceAccount(value)
+		return "", err
+	},
+}
+
+// Parses the certificate and returns a warning message if it's valid but has expired or
+// is about to expire within the configured window.
+func CheckCertificate(ctx context.Context, value string) (string, error) {
+	cert, err := ParseCertificate(value)
+	if err != nil {
+		return "", err
+	}
+	window, err := ExpiryWindow()
+	if err != nil {
+		tflog.Warn(ctx, "Using default certificate expiry window", map[string]any{"error": err.Error()})
+		window = DefaultExpiryWindow
+	}
+	return ExpiryWarning(cert, time.Now(), window), nil
+}
+
+// Implementation
+
+type materialValidator struct {
+	description string
+	validate    func(ctx context.Context, value string) (warning string, err error)
+}
+
+func (v materialValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v materialValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v materialValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	tflog.Trace(ctx, "Validating key material", map[string]any{"path": req.Path.String()})
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if len(value) == 0 {
+		return // we let the Required/Optional/Default attribute handle the empty value case
+	}
+
+	warning, err := v.validate(ctx, value)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(req.Path, "Invalid Key Material", fmt.Sprintf("Attribute %s %s: %s", req.Path, v.description, err.Error())))
+	} else if warning != "" {
+		resp.Diagnostics.Append(diag.NewAttributeWarningDiagnostic(req.Path, "Certificate Expiring", fmt.Sprintf("Attribute %s: %s", req.Path, warning)))
+	}
+}
@@ -1,26 +1,41 @@
 package managementkey
 
 import (
+	"time"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/durationattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strlistattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keyexpiry"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var ManagementKeyAttributes = map[string]schema.Attribute{
-	"id":            stringattr.Identifier(),
-	"name":          stringattr.Required(),
-	"description":   stringattr.Default("", stringattr.StandardLenValidator),
-	"status":        stringattr.Default("active", stringvalidator.OneOf("active", "inactive")),
-	"expire_time":   intattr.Default(0, int64planmodifier.RequiresReplace()),
-	"permitted_ips": strlistattr.Default(),
-	"rebac":         objattr.Required[ReBacModel](ReBacAttributes, ReBacValidator, objectplanmodifier.RequiresReplace()),
-	"cleartext":     stringattr.SecretGenerated(false),
+	"id":                 stringattr.Identifier(),
+	"name":               stringattr.Required(),
+	"description":        stringattr.Default("", stringattr.StandardLenValidator),
+	"status":             stringattr.Default("active", stringvalidator.OneOf("active", "inactive")),
+	"expire_time":        intattr.Default(0, int64planmodifier.RequiresReplace()),
+	"expires_in":         durationattr.ConfigOnly(stringplanmodifier.RequiresReplace()),
+	"renew_before":       durationattr.ConfigOnly(),
+	"expires_at":         stringattr.Generated(),
+	"permitted_ips":      strlistattr.Default(),
+	"rebac":              objattr.Required[ReBacModel](ReBacAttributes, ReBacValidator, objectplanmodifier.RequiresReplace()),
+	"cleartext":          stringattr.SecretGenerated(false),
+	"rotation":           rotation.Attribute(),
+	"previous_id":        stringattr.Generated(),
+	"previous_cleartext": stringattr.SecretGenerated(false),
+	"rotated_time":       intattr.Generated(),
 }
 
 var Schema = schema.Schema{
@@ -29,14 +44,21 @@ var Schema = schema.Schema{
 }
 
 type ManagementKeyModel struct {
-	ID           stringattr.Type          `tfsdk:"id"`
-	Name         stringattr.Type          `tfsdk:"name"`
-	Description  stringattr.Type          `tfsdk:"description"`
-	Status       stringattr.Type          `tfsdk:"status"`
-	ExpireTime   intattr.Type             `tfsdk:"expire_time"`
-	PermittedIPs strlistattr.Type         `tfsdk:"permitted_ips"`
-	ReBac        objattr.Type[ReBacModel] `tfsdk:"rebac"`
-	Cleartext    stringattr.Type          `tfsdk:"cleartext"`
+	ID                stringattr.Type                      `tfsdk:"id"`
+	Name              stringattr.Type                      `tfsdk:"name"`
+	Description       stringattr.Type                      `tfsdk:"description"`
+	Status            stringattr.Type                      `tfsdk:"status"`
+	ExpireTime        intattr.Type                         `tfsdk:"expire_time"`
+	ExpiresIn         durationattr.Type                    `tfsdk:"expires_in"`
+	RenewBefore       durationattr.Type                    `tfsdk:"renew_before"`
+	ExpiresAt         stringattr.Type                      `tfsdk:"expires_at"`
+	PermittedIPs      strlistattr.Type                     `tfsdk:"permitted_ips"`
+	ReBac             objattr.Type[ReBacModel]             `tfsdk:"rebac"`
+	Cleartext         stringattr.Type                      `tfsdk:"cleartext"`
+	Rotation          objattr.Type[rotation.RotationModel] `tfsdk:"rotation"`
+	PreviousID        stringattr.Type                      `tfsdk:"previous_id"`
+	PreviousCleartext stringattr.Type                      `tfsdk:"previous_cleartext"`
+	RotatedTime       intattr.Type                         `tfsdk:"rotated_time"`
 }
 
 func (m *ManagementKeyModel) Values(h *helpers.Handler) map[string]any {
@@ -44,7 +66,7 @@ func (m *ManagementKeyModel) Values(h *helpers.Handler) map[string]any {
 	stringattr.Get(m.Name, data, "name")
 	stringattr.Get(m.Description, data, "description")
 	stringattr.Get(m.Status, data, "status")
-	intattr.Get(m.ExpireTime, data, "expireTime")
+	keyexpiry.Get(m.ExpireTime, m.ExpiresIn, m.ExpiresAt, data, "expireTime", time.Now())
 	strlistattr.Get(m.PermittedIPs, data, "permittedIps", h)
 	objattr.Get(m.ReBac, data, "reBac", h)
 	if m.ID.ValueString() == "" && m.Status.ValueString() == "inactive" {
@@ -57,12 +79,44 @@ func (m *ManagementKeyModel) SetValues(h *helpers.Handler, data map[string]any)
 	stringattr.Set(&m.Name, data, "name")
 	stringattr.Set(&m.Description, data, "description")
 	stringattr.Set(&m.Status, data, "status")
-	intattr.Set(&m.ExpireTime, data, "expireTime")
+	keyexpiry.Set(&m.ExpireTime, m.ExpiresIn, &m.ExpiresAt, data, "expireTime")
 	strlistattr.Set(&m.PermittedIPs, data, "permittedIps", h)
 	objattr.Set(&m.ReBac, data, "reBac", h)
 	stringattr.Set(&m.Cleartext, data, "cleartext")
 }
 
+func (m *ManagementKeyModel) ModifyPlan(h *helpers.Handler, state *ManagementKeyModel) path.Paths {
+	var expiresAt stringattr.Type
+	var stateFields *rotation.Fields
+	if state != nil {
+		expiresAt = state.ExpiresAt
+		f := state.RotationFields()
+		stateFields = &f
+	}
+	renew := keyexpiry.Check(h, "management key", m.Name.ValueString(), m.ExpireTime, m.ExpiresIn, m.RenewBefore, expiresAt, time.Now())
+
+	// a rotated key is a new key with its own id and expiration time
+	fields := m.RotationFields()
+	action := rotation.Plan(h, fields, stateFields, time.Now())
+	if renew {
+		if stateFields == nil || !fields.Enabled(h) {
+			m.ExpiresAt = types.StringUnknown()
+			return path.Paths{path.Root("expires_at")}
+		}
+		action = rotation.ActionRotate // renewed through rotation so the current key remains valid for the overlap duration
+	}
+	fields.Apply(action)
+	if action == rotation.ActionRotate {
+		m.ID = types.StringUnknown()
+		m.ExpiresAt = types.StringUnknown()
+	}
+	return nil
+}
+
+func (m *ManagementKeyModel) RotationFields() rotation.Fields {
+	return rotation.Fields{Rotation: &m.Rotation, Secret: &m.Cleartext, PreviousSecret: &m.PreviousCleartext, PreviousID: &m.PreviousID, RotatedTime: &m.RotatedTime}
+}
+
 func (m *ManagementKeyModel) GetID() stringattr.Type {
 	return m.ID
 }
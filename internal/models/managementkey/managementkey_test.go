@@ -82,6 +82,18 @@ func TestManagementKey(t *testing.T) {
 			ResourceName: m.Path(),
 			ImportState:  true,
 		},
+		// Test import by name lookup
+		resource.TestStep{
+			ResourceName:      m.Path(),
+			ImportState:       true,
+			ImportStateIdFunc: testacc.GenerateImportStateLookupID(m.Path(), "name", "name"),
+		},
+		// Test import with resource identity
+		resource.TestStep{
+			ResourceName:    m.Path(),
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
 		// Test basic creation with company_roles
 		resource.TestStep{
 			PreConfig: func() {
@@ -148,6 +160,7 @@ func TestManagementKey(t *testing.T) {
 				"description": "With project roles",
 				"status":      "active",
 				"expire_time": "1893456000",
+				"expires_at":  "2030-01-01T00:00:00Z",
 				"rebac.project_roles": map[string]any{
 					"#":               "1",
 					"0.project_ids.#": "1",
@@ -156,6 +169,17 @@ func TestManagementKey(t *testing.T) {
 				},
 			}),
 		},
+		// Test expire_time and expires_in cannot both be set
+		resource.TestStep{
+			Config: p.Config() + m.Config(`
+				expire_time = 1893456000
+				expires_in = "90 days"
+				rebac = {
+					company_roles = ["company-full-access"]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`cannot both be set`),
+		},
 		// Destroy resource
 		resource.TestStep{
 			Config: p.Config() + m.Config(`
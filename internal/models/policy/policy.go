@@ -0,0 +1,78 @@
+package policy
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// The identifiers of the built-in checks that are run on production projects.
+const (
+	CheckTestUsers            = "test_users"
+	CheckInsecureConnectors   = "insecure_connectors"
+	CheckIframeEmbedding      = "iframe_embedding"
+	CheckCookiePolicy         = "cookie_policy"
+	CheckRefreshTokenRotation = "refresh_token_rotation"
+	CheckPasswordStrength     = "password_strength"
+)
+
+// All the built-in checks, for validating the skip_checks attribute in the provider configuration.
+var Checks = []string{
+	CheckTestUsers,
+	CheckInsecureConnectors,
+	CheckIframeEmbedding,
+	CheckCookiePolicy,
+	CheckRefreshTokenRotation,
+	CheckPasswordStrength,
+}
+
+// The ways in which a failed check can be reported.
+const (
+	EnforcementWarning  = "warning"
+	EnforcementError    = "error"
+	EnforcementDisabled = "disabled"
+)
+
+// All the valid values for the production_checks attribute in the provider configuration.
+var Enforcements = []string{EnforcementWarning, EnforcementError, EnforcementDisabled}
+
+// The summary of the diagnostic produced when a check fails.
+const ViolationSummary = "Production Policy Violation"
+
+// A Policy determines how risky settings in production projects are reported, and which
+// custom rules are evaluated against the resources.
+type Policy struct {
+	Enforcement string
+	SkipChecks  []string
+	Rules       []*Rule
+}
+
+// The policy used when the provider configuration doesn't have a policy block, which
+// reports all failed checks as warnings.
+var DefaultPolicy = &Policy{Enforcement: EnforcementWarning}
+
+// Returns whether the check with the given identifier should be run.
+func (p *Policy) Enabled(check string) bool {
+	if p == nil {
+		p = DefaultPolicy
+	}
+	return p.Enforcement != EnforcementDisabled && !slices.Contains(p.SkipChecks, check)
+}
+
+// Reports a failed check as either a warning or an error, depending on the policy's enforcement.
+func (p *Policy) Report(h *helpers.Handler, check string, format string, a ...any) {
+	if !p.Enabled(check) {
+		return
+	}
+	detail := fmt.Sprintf("[%s] %s. ", check, fmt.Sprintf(format, a...)) + hint(check)
+	if p != nil && p.Enforcement == EnforcementError {
+		h.Error(ViolationSummary, "%s", detail)
+	} else {
+		h.Warn(ViolationSummary, "%s", detail)
+	}
+}
+
+func hint(check string) string {
+	return fmt.Sprintf("This check can be skipped by adding '%s' to the skip_checks attribute in the policy block of the provider configuration.", check)
+}
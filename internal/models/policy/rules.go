@@ -0,0 +1,237 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/tryfunc"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// The summaries of the diagnostics produced when a custom rule fails or can't be evaluated.
+const (
+	RuleViolationSummary = "Policy Rule Violation"
+	RuleErrorSummary     = "Policy Rule Error"
+)
+
+// The resource types that rules can be evaluated against.
+const (
+	ProjectResource       = "project"
+	AccessKeyResource     = "access_key"
+	ManagementKeyResource = "management_key"
+)
+
+var RuleResources = []string{ProjectResource, AccessKeyResource, ManagementKeyResource}
+
+// A Rule is a custom check from the policy file, whose condition is evaluated against the
+// payload of a resource that's sent to the Descope API.
+type Rule struct {
+	ID        string
+	Resource  string
+	Message   string
+	Severity  string
+	Condition hcl.Expression
+}
+
+var rulesFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "rule", LabelNames: []string{"id"}},
+	},
+}
+
+var ruleSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "condition", Required: true},
+		{Name: "message", Required: true},
+		{Name: "resource"},
+		{Name: "severity"},
+	},
+}
+
+// Loads the custom rules from the policy file at the given path.
+func LoadRules(path string) ([]*Rule, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the policy file: %w", err)
+	}
+	return ParseRules(src, path)
+}
+
+// Parses the custom rules from the contents of a policy file, where each rule is defined
+// in a block such as:
+//
+//	rule "short-sessions" {
+//	  resource  = "project"
+//	  condition = input.settings.sessionTokenExpiration <= 15
+//	  message   = "Session tokens must expire within 15 minutes"
+//	  severity  = "warning"
+//	}
+func ParseRules(src []byte, filename string) ([]*Rule, error) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	content, diags := file.Body.Content(rulesFileSchema)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	rules := []*Rule{}
+	for _, block := range content.Blocks {
+		rule, diags := parseRule(block)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		if slices.ContainsFunc(rules, func(r *Rule) bool { return r.ID == rule.ID }) {
+			return nil, fmt.Errorf("%s: the rule ID '%s' is used more than once", block.DefRange, rule.ID)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func parseRule(block *hcl.Block) (*Rule, hcl.Diagnostics) {
+	content, diags := block.Body.Content(ruleSchema)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	rule := &Rule{ID: block.Labels[0], Resource: ProjectResource, Severity: EnforcementError, Condition: content.Attributes["condition"].Expr}
+	for name, target := range map[string]*string{"message": &rule.Message, "resource": &rule.Resource, "severity": &rule.Severity} {
+		attr, ok := content.Attributes[name]
+		if !ok {
+			continue
+		}
+		value, diags := attr.Expr.Value(nil)
+		if !diags.HasErrors() && (value.Type() != cty.String || value.IsNull()) {
+			diags = diags.Append(&hcl.Diagnostic{Severity: hcl.DiagError, Summary: "Invalid rule attribute", Detail: "The " + name + " attribute must be a string", Subject: attr.Expr.Range().Ptr()})
+		}
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		*target = value.AsString()
+	}
+
+	if !slices.Contains(RuleResources, rule.Resource) {
+		return nil, hcl.Diagnostics{{Severity: hcl.DiagError, Summary: "Invalid rule attribute", Detail: fmt.Sprintf("The resource attribute must be one of: %v", RuleResources), Subject: content.Attributes["resource"].Expr.Range().Ptr()}}
+	}
+	if rule.Severity != EnforcementError && rule.Severity != EnforcementWarning {
+		return nil, hcl.Diagnostics{{Severity: hcl.DiagError, Summary: "Invalid rule attribute", Detail: "The severity attribute must be either 'error' or 'warning'", Subject: content.Attributes["severity"].Expr.Range().Ptr()}}
+	}
+	return rule, nil
+}
+
+// Returns whether the policy has any custom rules for the given resource type.
+func (p *Policy) HasRules(resource string) bool {
+	return p != nil && slices.ContainsFunc(p.Rules, func(r *Rule) bool { return r.Resource == resource })
+}
+
+// Evaluates the custom rules for the given resource type against the resource's payload, and reports
+// any rules whose condition isn't true. Rules whose condition can't be determined at plan time are skipped.
+func (p *Policy) EvaluateRules(h *helpers.Handler, resource string, values map[string]any) {
+	if !p.HasRules(resource) {
+		return
+	}
+
+	input, err := payloadValue(values)
+	if err != nil {
+		h.Error(RuleErrorSummary, "Failed to prepare the %s payload for evaluating policy rules: %s", resource, err.Error())
+		return
+	}
+
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{"input": input, "resource": cty.StringVal(resource)},
+		Functions: ruleFunctions,
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Resource != resource {
+			continue
+		}
+		result, diags := rule.Condition.Value(ctx)
+		if !diags.HasErrors() {
+			result, err = convert.Convert(result, cty.Bool)
+			if err != nil || result.IsNull() {
+				diags = diags.Append(&hcl.Diagnostic{Severity: hcl.DiagError, Summary: "Invalid condition", Detail: "The condition must evaluate to a bool value", Subject: rule.Condition.Range().Ptr()})
+			}
+		}
+		if diags.HasErrors() {
+			h.Error(RuleErrorSummary, "[%s] The condition of the rule could not be evaluated: %s", rule.ID, diags.Error())
+			continue
+		}
+		if !result.IsKnown() || result.True() {
+			continue
+		}
+		if rule.Severity == EnforcementWarning {
+			h.Warn(RuleViolationSummary, "[%s] %s", rule.ID, rule.Message)
+		} else {
+			h.Error(RuleViolationSummary, "[%s] %s", rule.ID, rule.Message)
+		}
+	}
+}
+
+// Converts the JSON compatible payload of a resource into a value that can be used in rule conditions.
+func payloadValue(values map[string]any) (cty.Value, error) {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	ty, err := ctyjson.ImpliedType(b)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return ctyjson.Unmarshal(b, ty)
+}
+
+// The functions that are available in rule conditions.
+var ruleFunctions = map[string]function.Function{
+	"alltrue":    boolListFunc(true),
+	"anytrue":    boolListFunc(false),
+	"can":        tryfunc.CanFunc,
+	"coalesce":   stdlib.CoalesceFunc,
+	"contains":   stdlib.ContainsFunc,
+	"flatten":    stdlib.FlattenFunc,
+	"keys":       stdlib.KeysFunc,
+	"length":     stdlib.LengthFunc,
+	"lookup":     stdlib.LookupFunc,
+	"lower":      stdlib.LowerFunc,
+	"max":        stdlib.MaxFunc,
+	"min":        stdlib.MinFunc,
+	"regex":      stdlib.RegexFunc,
+	"strlen":     stdlib.StrlenFunc,
+	"trimprefix": stdlib.TrimPrefixFunc,
+	"trimsuffix": stdlib.TrimSuffixFunc,
+	"try":        tryfunc.TryFunc,
+	"upper":      stdlib.UpperFunc,
+	"values":     stdlib.ValuesFunc,
+}
+
+// Returns a function that checks whether all (or any) of the elements in a list of bool values are true.
+func boolListFunc(all bool) function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{{Name: "list", Type: cty.List(cty.Bool)}},
+		Type:   function.StaticReturnType(cty.Bool),
+		Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+			for it := args[0].ElementIterator(); it.Next(); {
+				_, v := it.Element()
+				if !v.IsKnown() {
+					return cty.UnknownVal(cty.Bool), nil
+				}
+				if !v.IsNull() && v.True() != all {
+					return cty.BoolVal(!all), nil
+				}
+			}
+			return cty.BoolVal(all), nil
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRules = `
+rule "short-sessions" {
+  condition = input.settings.sessionTokenExpiration <= 15
+  message   = "Session tokens must expire within 15 minutes"
+}
+
+rule "no-smtp" {
+  condition = length(try(input.connectors.smtp, [])) == 0
+  message   = "SMTP connectors are not allowed"
+  severity  = "warning"
+}
+
+rule "role-descriptions" {
+  condition = alltrue([for r in input.authorization.roles : r.description != ""])
+  message   = "Every role must have a description"
+}
+
+rule "key-expiration" {
+  resource  = "access_key"
+  condition = input.expireTime > 0
+  message   = "Access keys must have an expiration time"
+}
+`
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules), "policy.hcl")
+	require.NoError(t, err)
+	require.Len(t, rules, 4)
+	assert.Equal(t, "short-sessions", rules[0].ID)
+	assert.Equal(t, ProjectResource, rules[0].Resource)
+	assert.Equal(t, EnforcementError, rules[0].Severity)
+	assert.Equal(t, EnforcementWarning, rules[1].Severity)
+	assert.Equal(t, AccessKeyResource, rules[3].Resource)
+
+	// rules are loaded from a local file
+	path := filepath.Join(t.TempDir(), "policy.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(testRules), 0o600))
+	rules, err = LoadRules(path)
+	require.NoError(t, err)
+	assert.Len(t, rules, 4)
+	_, err = LoadRules(path + ".missing")
+	assert.ErrorContains(t, err, "failed to read the policy file")
+
+	// invalid rule documents are rejected with their location
+	_, err = ParseRules([]byte(`rule "foo" {`), "policy.hcl")
+	assert.ErrorContains(t, err, "policy.hcl:1")
+	_, err = ParseRules([]byte(`rule "foo" { message = "foo" }`), "policy.hcl")
+	assert.ErrorContains(t, err, `"condition" is required`)
+	_, err = ParseRules([]byte(`rule "foo" {
+		condition = true
+		message   = "foo"
+		resource  = "descoper"
+	}`), "policy.hcl")
+	assert.ErrorContains(t, err, "policy.hcl:4")
+	_, err = ParseRules([]byte(`rule "foo" {
+		condition = true
+		message   = "foo"
+		severity  = "fatal"
+	}`), "policy.hcl")
+	assert.ErrorContains(t, err, "severity attribute")
+	_, err = ParseRules([]byte(`
+		rule "foo" {
+			condition = true
+			message   = "foo"
+		}
+		rule "foo" {
+			condition = false
+			message   = "bar"
+		}`), "policy.hcl")
+	assert.ErrorContains(t, err, "used more than once")
+}
+
+func TestEvaluateRules(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules), "policy.hcl")
+	require.NoError(t, err)
+	p := &Policy{Enforcement: EnforcementWarning, Rules: rules}
+	assert.True(t, p.HasRules(ProjectResource))
+	assert.True(t, p.HasRules(AccessKeyResource))
+	assert.False(t, p.HasRules(ManagementKeyResource))
+
+	// a compliant payload doesn't produce any diagnostics
+	diags := evaluateRules(p, ProjectResource, map[string]any{
+		"settings":      map[string]any{"sessionTokenExpiration": 10},
+		"connectors":    map[string]any{},
+		"authorization": map[string]any{"roles": []any{map[string]any{"name": "Admin", "description": "Administrator"}}},
+	})
+	assert.Empty(t, diags)
+
+	// violations are reported with their rule IDs and severities
+	diags = evaluateRules(p, ProjectResource, map[string]any{
+		"settings":      map[string]any{"sessionTokenExpiration": 60},
+		"connectors":    map[string]any{"smtp": []any{map[string]any{"name": "SMTP"}}},
+		"authorization": map[string]any{"roles": []any{map[string]any{"name": "Admin", "description": ""}}},
+	})
+	require.Equal(t, 2, diags.ErrorsCount())
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, RuleViolationSummary, diags[0].Summary())
+	assert.Equal(t, "[short-sessions] Session tokens must expire within 15 minutes", diags[0].Detail())
+	assert.Equal(t, "[no-smtp] SMTP connectors are not allowed", diags[1].Detail())
+	assert.Equal(t, "[role-descriptions] Every role must have a description", diags[2].Detail())
+
+	// conditions that can't be evaluated are reported as errors
+	diags = evaluateRules(p, AccessKeyResource, map[string]any{"name": "foo"})
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, RuleErrorSummary, diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "[key-expiration]")
+}
+
+func evaluateRules(p *Policy, resource string, values map[string]any) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	p.EvaluateRules(helpers.NewHandler(context.Background(), &diags), resource, values)
+	return diags
+}
@@ -51,9 +51,9 @@ func (m *ApplicationsModel) Check(h *helpers.Handler) {
 }
 
 func (m *ApplicationsModel) Modify(h *helpers.Handler, state *ApplicationsModel) {
-	listattr.ModifyMatchingNames(h, &m.OIDCApplications, state.OIDCApplications)
-	listattr.ModifyMatchingNames(h, &m.SAMLApplications, state.SAMLApplications)
-	listattr.ModifyMatchingNames(h, &m.WSFedApplications, state.WSFedApplications)
+	listattr.ModifyMatchingKeysOrNames(h, &m.OIDCApplications, state.OIDCApplications)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SAMLApplications, state.SAMLApplications)
+	listattr.ModifyMatchingKeysOrNames(h, &m.WSFedApplications, state.WSFedApplications)
 
 	for p := range listattr.Iterator(m.OIDCApplications, h) {
 		for s := range listattr.Iterator(state.OIDCApplications, h) {
@@ -75,6 +75,38 @@ func (m *ApplicationsModel) Validate(h *helpers.Handler) {
 	for app := range listattr.Iterator(m.WSFedApplications, h) {
 		validateSSOAppRoles(h, app.Name, app.Permissions, app.Roles)
 	}
+
+	entityIDs := map[string]int{}
+	for app := range listattr.Iterator(m.SAMLApplications, h) {
+		if app.ManualConfiguration.IsSet() && !app.ManualConfiguration.IsUnknown() && !app.DynamicConfiguration.IsSet() {
+			if config, _ := app.ManualConfiguration.ToObject(h.Ctx); config != nil {
+				if entityID := config.effectiveEntityID(); entityID != "" {
+					entityIDs[entityID] += 1
+				}
+			}
+		}
+	}
+	for k, v := range entityIDs {
+		if v > 1 {
+			h.Error("SAML application entity IDs must be unique", "The entity ID '%s' is used by %d SAML applications", k, v)
+		}
+	}
+
+	keys := map[string]int{}
+	addApplicationKeys(h, keys, "oidc_applications", m.OIDCApplications)
+	addApplicationKeys(h, keys, "saml_applications", m.SAMLApplications)
+	addApplicationKeys(h, keys, "wsfed_applications", m.WSFedApplications)
+	for k, v := range keys {
+		if v > 1 {
+			h.Error("Application keys must be unique", "The application key '%s' is used %d times", k, v)
+		}
+	}
+}
+
+func addApplicationKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, keys map[string]int, attribute string, apps listattr.Type[T]) {
+	if !listattr.CollectKeys[T, M](h, keys, apps) {
+		h.Missing("The 'key' attribute must be set in all objects in the '%s' list", attribute)
+	}
 }
 
 func validateSSOAppRoles(h *helpers.Handler, appName stringattr.Type, perms listattr.Type[SSOAppPermissionModel], roles listattr.Type[SSOAppRoleModel]) {
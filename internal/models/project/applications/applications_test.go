@@ -385,6 +385,103 @@ func TestApplications(t *testing.T) {
 				},
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				applications = {
+					saml_applications = [
+						{
+							name = "meh"
+							manual_configuration = {
+								entity_id = "foo"
+							}
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`acs_url attribute must be set`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				applications = {
+					saml_applications = [
+						{
+							name = "meh"
+							manual_configuration = {
+								metadata_xml = <<-EOT
+									<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com">
+										<md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+											<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="https://sp.example.com/acs" index="0"/>
+										</md:SPSSODescriptor>
+									</md:EntityDescriptor>
+								EOT
+							}
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`supported[\s\S]*binding`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				applications = {
+					saml_applications = [
+						{
+							name = "meh"
+							manual_configuration = {
+								metadata_xml = <<-EOT
+									<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com">
+										<md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+											<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/>
+										</md:SPSSODescriptor>
+									</md:EntityDescriptor>
+								EOT
+							}
+						},
+						{
+							name = "bar"
+							manual_configuration = {
+								acs_url = "https://example.com/acs"
+								entity_id = "https://sp.example.com"
+							}
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`entity IDs must be unique`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				applications = {
+					saml_applications = [
+						{
+							name = "meh"
+							manual_configuration = {
+								metadata_xml = <<-EOT
+									<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com">
+										<md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+											<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/>
+										</md:SPSSODescriptor>
+									</md:EntityDescriptor>
+								EOT
+							}
+						}
+					]
+				}
+			`),
+			Check: p.Check(map[string]any{
+				"applications.saml_applications.#": 1,
+				"applications.saml_applications.0": map[string]any{
+					"id":                      testacc.AttributeHasPrefix("SA"),
+					"name":                    "meh",
+					"dynamic_configuration.%": 0,
+					"manual_configuration": map[string]any{
+						"acs_url":     "https://sp.example.com/acs",
+						"entity_id":   "https://sp.example.com",
+						"certificate": "",
+					},
+				},
+			}),
+		},
 		// WS-Fed
 		resource.TestStep{
 			Config: p.Config(`
@@ -0,0 +1,133 @@
+package applications
+
+import (
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
+)
+
+// The only binding Descope supports for sending SAML responses to the ACS URL of an SP.
+const samlBindingHTTPPost = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+// The values needed for a manual SAML application configuration, as parsed from the SP metadata.
+type samlMetadata struct {
+	EntityID    string
+	ACSURL      string
+	Certificate string
+}
+
+// Parses SP metadata XML and returns the values for the manual configuration. The metadata must
+// have an AssertionConsumerService with the HTTP-POST binding, and if it includes a signing
+// certificate then it must be a valid X.509 certificate.
+func parseSAMLMetadata(data string) (*samlMetadata, error) {
+	var root struct {
+		XMLName  xml.Name
+		EntityID string               `xml:"entityID,attr"`
+		SP       []samlSPDescriptor   `xml:"SPSSODescriptor"`
+		Entities []samlEntityMetadata `xml:"EntityDescriptor"`
+	}
+	if err := xml.Unmarshal([]byte(data), &root); err != nil {
+		return nil, fmt.Errorf("the metadata is not well-formed XML: %w", err)
+	}
+
+	entity := samlEntityMetadata{EntityID: root.EntityID, SP: root.SP}
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+	case "EntitiesDescriptor":
+		if len(root.Entities) != 1 {
+			return nil, fmt.Errorf("expected the metadata to have exactly one EntityDescriptor but found %d", len(root.Entities))
+		}
+		entity = root.Entities[0]
+	default:
+		return nil, fmt.Errorf("expected the metadata root element to be an EntityDescriptor but found %s", root.XMLName.Local)
+	}
+
+	if entity.EntityID == "" {
+		return nil, errors.New("the EntityDescriptor is missing an entityID attribute")
+	}
+	if len(entity.SP) != 1 {
+		return nil, fmt.Errorf("expected the metadata to have exactly one SPSSODescriptor but found %d", len(entity.SP))
+	}
+	sp := entity.SP[0]
+
+	acs, err := sp.assertionConsumerService()
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := sp.signingCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &samlMetadata{EntityID: entity.EntityID, ACSURL: acs.Location, Certificate: cert}, nil
+}
+
+type samlEntityMetadata struct {
+	EntityID string             `xml:"entityID,attr"`
+	SP       []samlSPDescriptor `xml:"SPSSODescriptor"`
+}
+
+type samlSPDescriptor struct {
+	KeyDescriptors []struct {
+		Use          string   `xml:"use,attr"`
+		Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+	} `xml:"KeyDescriptor"`
+	AssertionConsumerServices []samlEndpoint `xml:"AssertionConsumerService"`
+}
+
+type samlEndpoint struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+// Returns the default HTTP-POST endpoint, or the one with the lowest index if none is marked as the default.
+func (sp *samlSPDescriptor) assertionConsumerService() (*samlEndpoint, error) {
+	var result *samlEndpoint
+	bindings := []string{}
+	for i := range sp.AssertionConsumerServices {
+		acs := &sp.AssertionConsumerServices[i]
+		if acs.Binding != samlBindingHTTPPost {
+			bindings = append(bindings, acs.Binding)
+			continue
+		}
+		if acs.Location == "" {
+			return nil, errors.New("the metadata has an AssertionConsumerService without a Location attribute")
+		}
+		if result == nil || (acs.IsDefault && !result.IsDefault) || (acs.IsDefault == result.IsDefault && acs.Index < result.Index) {
+			result = acs
+		}
+	}
+	if result == nil {
+		if len(bindings) == 0 {
+			return nil, errors.New("the metadata doesn't have an AssertionConsumerService")
+		}
+		slices.Sort(bindings)
+		return nil, fmt.Errorf("the metadata doesn't have an AssertionConsumerService with the supported %s binding, found: %s", samlBindingHTTPPost, strings.Join(slices.Compact(bindings), ", "))
+	}
+	return result, nil
+}
+
+// Returns the PEM encoded signing certificate if there is one, or an empty string otherwise.
+func (sp *samlSPDescriptor) signingCertificate() (string, error) {
+	for _, kd := range sp.KeyDescriptors {
+		if kd.Use != "" && kd.Use != "signing" {
+			continue
+		}
+		for _, value := range kd.Certificates {
+			cert, err := keymaterial.ParseCertificate(value)
+			if err != nil {
+				return "", fmt.Errorf("the signing certificate in the metadata is invalid: %w", err)
+			}
+			return strings.TrimSpace(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))), nil
+		}
+	}
+	return "", nil
+}
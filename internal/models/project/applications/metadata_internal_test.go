@@ -0,0 +1,99 @@
+package applications
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSAMLMetadata verifies that the values for a manual SAML configuration are
+// extracted from SP metadata, and that unsupported metadata is rejected.
+func TestSAMLMetadata(t *testing.T) {
+	der := testCertificate(t)
+	cert := base64.StdEncoding.EncodeToString(der)
+
+	// a complete metadata document yields the default HTTP-POST endpoint and the PEM encoded certificate
+	metadata, err := parseSAMLMetadata(testMetadata("https://sp.example.com", cert, `
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="https://sp.example.com/artifact" index="0" isDefault="true"/>
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs2" index="2"/>
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs1" index="1"/>
+	`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://sp.example.com", metadata.EntityID)
+	assert.Equal(t, "https://sp.example.com/acs1", metadata.ACSURL)
+	block, _ := pem.Decode([]byte(metadata.Certificate))
+	require.NotNil(t, block)
+	assert.Equal(t, der, block.Bytes)
+
+	// an endpoint marked as the default takes precedence over the index order
+	metadata, err = parseSAMLMetadata(testMetadata("https://sp.example.com", "", `
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs1" index="1"/>
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs2" index="2" isDefault="true"/>
+	`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://sp.example.com/acs2", metadata.ACSURL)
+	assert.Equal(t, "", metadata.Certificate)
+
+	// a single entity wrapped in an EntitiesDescriptor is accepted
+	wrapped := `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` + testMetadata("https://sp.example.com", "", testPostEndpoint) + `</md:EntitiesDescriptor>`
+	metadata, err = parseSAMLMetadata(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, "https://sp.example.com", metadata.EntityID)
+
+	// metadata without an HTTP-POST endpoint lists the bindings that were found
+	_, err = parseSAMLMetadata(testMetadata("https://sp.example.com", "", `
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="https://sp.example.com/artifact" index="0"/>
+	`))
+	assert.ErrorContains(t, err, "found: urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact")
+
+	// a certificate that isn't valid is rejected
+	_, err = parseSAMLMetadata(testMetadata("https://sp.example.com", base64.StdEncoding.EncodeToString([]byte("foo")), testPostEndpoint))
+	assert.ErrorContains(t, err, "certificate could not be parsed")
+	_, err = parseSAMLMetadata(testMetadata("https://sp.example.com", "!!!", testPostEndpoint))
+	assert.ErrorContains(t, err, "base64 encoded certificate data")
+
+	// other malformed or incomplete metadata is rejected
+	_, err = parseSAMLMetadata(`<md:EntityDescriptor`)
+	assert.ErrorContains(t, err, "not well-formed XML")
+	_, err = parseSAMLMetadata(testMetadata("", "", testPostEndpoint))
+	assert.ErrorContains(t, err, "missing an entityID")
+	_, err = parseSAMLMetadata(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com"></md:EntityDescriptor>`)
+	assert.ErrorContains(t, err, "exactly one SPSSODescriptor")
+	_, err = parseSAMLMetadata(`<foo/>`)
+	assert.ErrorContains(t, err, "root element")
+}
+
+const testPostEndpoint = `<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/>`
+
+func testMetadata(entityID, cert, endpoints string) string {
+	keys := ""
+	if cert != "" {
+		keys = fmt.Sprintf(`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`, cert)
+	}
+	return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s"><md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">%s%s</md:SPSSODescriptor></md:EntityDescriptor>`, entityID, keys, endpoints)
+}
+
+func testCertificate(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
@@ -13,6 +13,7 @@ import (
 
 var OIDCAttributes = map[string]schema.Attribute{
 	"id":          stringattr.Optional(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 	"logo":        stringattr.Default(""),
@@ -41,6 +42,7 @@ var OIDCAttributes = map[string]schema.Attribute{
 
 type OIDCModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 	Logo        stringattr.Type `tfsdk:"logo"`
@@ -114,6 +116,10 @@ func (m *OIDCModel) SetValues(h *helpers.Handler, data map[string]any) {
 
 // Matching
 
+func (m *OIDCModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *OIDCModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -7,12 +7,14 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strsetattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 var SAMLAttributes = map[string]schema.Attribute{
 	"id":          stringattr.Optional(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 	"logo":        stringattr.Default(""),
@@ -20,7 +22,7 @@ var SAMLAttributes = map[string]schema.Attribute{
 
 	"login_page_url":              stringattr.Default(""),
 	"dynamic_configuration":       objattr.Default[DynamicConfigurationModel](nil, DynamicConfigurationAttributes),
-	"manual_configuration":        objattr.Default[ManualConfigurationModel](nil, ManualConfigurationAttributes),
+	"manual_configuration":        objattr.Default[ManualConfigurationModel](nil, ManualConfigurationAttributes, ManualConfigurationValidator, ManualConfigurationModifier),
 	"acs_allowed_callback_urls":   strsetattr.Default(),
 	"subject_name_id_type":        stringattr.Default("", stringvalidator.OneOf("", "email", "phone")),
 	"subject_name_id_format":      stringattr.Default("", stringvalidator.OneOf("", "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress", "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent", "urn:oasis:names:tc:SAML:2.0:nameid-format:transient")),
@@ -37,6 +39,7 @@ var SAMLAttributes = map[string]schema.Attribute{
 
 type SAMLModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 	Logo        stringattr.Type `tfsdk:"logo"`
@@ -104,6 +107,10 @@ func (m *SAMLModel) SetValues(h *helpers.Handler, data map[string]any) {
 
 // Matching
 
+func (m *SAMLModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SAMLModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -162,16 +169,22 @@ func (m *DynamicConfigurationModel) SetValues(h *helpers.Handler, data map[strin
 
 // Manual Configuration
 
+var ManualConfigurationValidator = objattr.NewValidator[ManualConfigurationModel]("must have a valid manual configuration")
+
+var ManualConfigurationModifier = objattr.NewModifier[ManualConfigurationModel]("fills the manual configuration from the metadata XML", objattr.ModifierAllowNullState)
+
 var ManualConfigurationAttributes = map[string]schema.Attribute{
-	"acs_url":     stringattr.Required(),
-	"entity_id":   stringattr.Required(),
-	"certificate": stringattr.Default(""),
+	"acs_url":      stringattr.Default(""),
+	"entity_id":    stringattr.Default(""),
+	"certificate":  stringattr.Default("", keymaterial.CertificateValidator),
+	"metadata_xml": stringattr.Default(""),
 }
 
 type ManualConfigurationModel struct {
 	ACSURL      stringattr.Type `tfsdk:"acs_url"`
 	EntityID    stringattr.Type `tfsdk:"entity_id"`
 	Certificate stringattr.Type `tfsdk:"certificate"`
+	MetadataXML stringattr.Type `tfsdk:"metadata_xml"`
 }
 
 func (m *ManualConfigurationModel) Values(h *helpers.Handler) map[string]any {
@@ -185,5 +198,79 @@ func (m *ManualConfigurationModel) Values(h *helpers.Handler) map[string]any {
 func (m *ManualConfigurationModel) SetValues(h *helpers.Handler, data map[string]any) {
 	stringattr.Set(&m.ACSURL, data, "acsUrl")
 	stringattr.Set(&m.EntityID, data, "entityId")
-	stringattr.Set(&m.Certificate, data, "certificate")
+	if m.MetadataXML.ValueString() != "" {
+		stringattr.Set(&m.Certificate, data, "certificate", stringattr.SkipIfAlreadySet) // the backend might format the certificate from the metadata differently
+	} else {
+		stringattr.Set(&m.Certificate, data, "certificate")
+	}
+	if m.MetadataXML.IsNull() || m.MetadataXML.IsUnknown() {
+		m.MetadataXML = stringattr.Value("")
+	}
+}
+
+func (m *ManualConfigurationModel) Validate(h *helpers.Handler) {
+	if helpers.HasUnknownValues(m.ACSURL, m.EntityID, m.MetadataXML) {
+		return // skip validation if there are unknown values
+	}
+
+	if m.MetadataXML.ValueString() == "" {
+		if m.ACSURL.ValueString() == "" {
+			h.Missing("The acs_url attribute must be set in the manual_configuration unless metadata_xml is set")
+		}
+		if m.EntityID.ValueString() == "" {
+			h.Missing("The entity_id attribute must be set in the manual_configuration unless metadata_xml is set")
+		}
+		return
+	}
+
+	metadata, err := parseSAMLMetadata(m.MetadataXML.ValueString())
+	if err != nil {
+		h.Invalid("The metadata_xml attribute is invalid: %s", err.Error())
+		return
+	}
+
+	if v := m.EntityID.ValueString(); v != "" && v != metadata.EntityID {
+		h.Warn("Entity ID Overrides Metadata", "The entity_id attribute value '%s' will be used instead of the value '%s' from the metadata_xml", v, metadata.EntityID)
+	}
+	if v := m.ACSURL.ValueString(); v != "" && v != metadata.ACSURL {
+		h.Warn("ACS URL Overrides Metadata", "The acs_url attribute value '%s' will be used instead of the value '%s' from the metadata_xml", v, metadata.ACSURL)
+	}
+	if m.Certificate.ValueString() == "" && metadata.Certificate != "" {
+		if warning, _ := keymaterial.CheckCertificate(h.Ctx, metadata.Certificate); warning != "" {
+			h.Warn("Certificate Expiring", "The signing certificate in the metadata_xml: %s", warning)
+		}
+	}
+}
+
+func (m *ManualConfigurationModel) Modify(h *helpers.Handler, _ *ManualConfigurationModel) {
+	if helpers.HasUnknownValues(m.MetadataXML) || m.MetadataXML.ValueString() == "" {
+		return
+	}
+
+	metadata, err := parseSAMLMetadata(m.MetadataXML.ValueString())
+	if err != nil {
+		return // reported by the validator
+	}
+
+	// values that are set explicitly take precedence over the ones in the metadata
+	if m.EntityID.ValueString() == "" {
+		m.EntityID = stringattr.Value(metadata.EntityID)
+	}
+	if m.ACSURL.ValueString() == "" {
+		m.ACSURL = stringattr.Value(metadata.ACSURL)
+	}
+	if m.Certificate.ValueString() == "" {
+		m.Certificate = stringattr.Value(metadata.Certificate)
+	}
+}
+
+// Returns the entity ID the application will be configured with, either set explicitly or from the metadata.
+func (m *ManualConfigurationModel) effectiveEntityID() string {
+	if v := m.EntityID.ValueString(); v != "" || m.MetadataXML.IsUnknown() {
+		return v
+	}
+	if metadata, err := parseSAMLMetadata(m.MetadataXML.ValueString()); err == nil {
+		return metadata.EntityID
+	}
+	return ""
 }
@@ -11,6 +11,7 @@ import (
 
 var WSFedAttributes = map[string]schema.Attribute{
 	"id":          stringattr.Optional(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 	"logo":        stringattr.Default(""),
@@ -34,6 +35,7 @@ var WSFedAttributes = map[string]schema.Attribute{
 
 type WSFedModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 	Logo        stringattr.Type `tfsdk:"logo"`
@@ -90,6 +92,10 @@ func (m *WSFedModel) SetValues(h *helpers.Handler, data map[string]any) {
 
 // Matching
 
+func (m *WSFedModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *WSFedModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -13,6 +13,7 @@ var AccessKeyAttributeModifier = objattr.NewModifier[AccessKeyAttributeModel]("e
 
 var AccessKeyAttributeAttributes = map[string]schema.Attribute{
 	"id":                   stringattr.Optional(stringattr.MachineIDValidator, stringvalidator.LengthAtMost(20)),
+	"key":                  stringattr.Default(""),
 	"name":                 stringattr.Required(stringattr.StandardLenValidator),
 	"type":                 stringattr.Required(attributeTypeValidator),
 	"select_options":       strsetattr.Default(),
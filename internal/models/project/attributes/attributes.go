@@ -2,10 +2,15 @@ package attributes
 
 import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var AttributesValidator = objattr.NewValidator[AttributesModel]("must have unique attribute keys")
+
+var AttributesModifier = objattr.NewModifier[AttributesModel]("maintains attribute identifiers between plan changes")
+
 var AttributesAttributes = map[string]schema.Attribute{
 	"tenant":     listattr.Default[TenantAttributeModel](TenantAttributeAttributes, TenantAttributeModifier),
 	"user":       listattr.Default[UserAttributeModel](UserAttributeAttributes, UserAttributeModifier),
@@ -31,3 +36,36 @@ func (m *AttributesModel) SetValues(h *helpers.Handler, data map[string]any) {
 	listattr.SetMatchingNames(&m.User, data, "user", "displayName", h)
 	listattr.SetMatchingNames(&m.AccessKey, data, "accessKey", "displayName", h)
 }
+
+func (m *AttributesModel) Validate(h *helpers.Handler) {
+	validateAttributeKeys(h, "tenant", m.Tenant)
+	validateAttributeKeys(h, "user", m.User)
+	validateAttributeKeys(h, "access_key", m.AccessKey)
+}
+
+func (m *AttributesModel) Modify(h *helpers.Handler, state *AttributesModel) {
+	modifyMatchingKeys(h, &m.Tenant, state.Tenant)
+	modifyMatchingKeys(h, &m.User, state.User)
+	modifyMatchingKeys(h, &m.AccessKey, state.AccessKey)
+}
+
+func validateAttributeKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, attribute string, attrs listattr.Type[T]) {
+	keys := map[string]int{}
+	if !listattr.CollectKeys[T, M](h, keys, attrs) {
+		h.Missing("The 'key' attribute must be set in all objects in the '%s' attributes list", attribute)
+	}
+	for k, v := range keys {
+		if v > 1 {
+			h.Error("Attribute keys must be unique", "The %s attribute key '%s' is used %d times", attribute, k, v)
+		}
+	}
+}
+
+// Attribute ids are derived from their names when not set explicitly, so unlike other lists we
+// only match existing attributes by key and never fall back to matching by name or order.
+func modifyMatchingKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, plan *listattr.Type[T], state listattr.Type[T]) {
+	keys := map[string]int{}
+	if listattr.CollectKeys[T, M](h, keys, state) && len(keys) > 0 {
+		listattr.ModifyMatchingKeys[T, M](h, plan, state)
+	}
+}
@@ -16,6 +16,7 @@ var attributeTypeValidator = stringvalidator.OneOf("string", "number", "boolean"
 
 type AttributeModel struct {
 	ID            stringattr.Type `tfsdk:"id"`
+	Key           stringattr.Type `tfsdk:"key"`
 	Name          stringattr.Type `tfsdk:"name"`
 	Type          stringattr.Type `tfsdk:"type"`
 	SelectOptions strsetattr.Type `tfsdk:"select_options"`
@@ -39,16 +40,33 @@ func (m *AttributeModel) SetValues(h *helpers.Handler, data map[string]any) {
 
 func (m *AttributeModel) Modify(h *helpers.Handler) {
 	if v := m.Name.ValueString(); v != "" && m.ID.IsUnknown() {
-		id := strcase.ToLowerCamel(v)
-		if len(id) > 20 {
-			id = id[:20]
-		}
-		m.ID = stringattr.Value(id)
+		m.ID = stringattr.Value(generateAttributeID(v))
 	}
 }
 
+// Returns the machine name of the attribute that's used to refer to it in other places, such
+// as JWT templates, or an empty string if it can't be determined yet.
+func (m *AttributeModel) AttributeID() string {
+	if m.ID.IsNull() {
+		return generateAttributeID(m.Name.ValueString())
+	}
+	return m.ID.ValueString()
+}
+
+func generateAttributeID(name string) string {
+	id := strcase.ToLowerCamel(name)
+	if len(id) > 20 {
+		id = id[:20]
+	}
+	return id
+}
+
 // Matching
 
+func (m *AttributeModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AttributeModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -7,13 +7,13 @@ import (
 )
 
 var AuthenticationAttributes = map[string]schema.Attribute{
-	"otp":            objattr.Default[OTPModel](nil, OTPAttributes),
-	"magic_link":     objattr.Default[MagicLinkModel](nil, MagicLinkAttributes),
-	"enchanted_link": objattr.Default[EnchantedLinkModel](nil, EnchantedLinkAttributes),
+	"otp":            objattr.Default[OTPModel](nil, OTPAttributes, OTPValidator),
+	"magic_link":     objattr.Default[MagicLinkModel](nil, MagicLinkAttributes, MagicLinkValidator),
+	"enchanted_link": objattr.Default[EnchantedLinkModel](nil, EnchantedLinkAttributes, EnchantedLinkValidator),
 	"embedded_link":  objattr.Default[EmbeddedLinkModel](nil, EmbeddedLinkAttributes),
-	"password":       objattr.Default[PasswordModel](nil, PasswordAttributes),
+	"password":       objattr.Default[PasswordModel](nil, PasswordAttributes, PasswordValidator, PasswordModifier),
 	"oauth":          objattr.Default[OAuthModel](nil, OAuthAttributes, OAuthValidator),
-	"sso":            objattr.Default[SSOModel](nil, SSOAttributes),
+	"sso":            objattr.Default[SSOModel](nil, SSOAttributes, SSOValidator),
 	"totp":           objattr.Default[TOTPModel](nil, TOTPAttributes),
 	"passkeys":       objattr.Default[PasskeysModel](nil, PasskeysAttributes),
 }
@@ -540,5 +540,51 @@ func TestAuthentication(t *testing.T) {
 				"authentication.password.enforce_strength":      "none",
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				authentication = {
+					password = {
+						reuse = true
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`reuse_amount attribute is required when reuse is enabled`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				authentication = {
+					password = {
+						lock = true
+						lock_attempts = 3
+						temporary_lock = true
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`must be greater than the temporary_lock_attempts value`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				authentication = {
+					password = {
+						preset = "pci_dss_4"
+						min_length = 16
+					}
+				}
+			`),
+			Check: p.Check(map[string]any{
+				"authentication.password": map[string]any{
+					"preset":           "pci_dss_4",
+					"min_length":       16,
+					"number":           true,
+					"any_letter":       true,
+					"expiration":       true,
+					"expiration_weeks": 12,
+					"lock":             true,
+					"lock_attempts":    10,
+					"reuse":            true,
+					"reuse_amount":     4,
+				},
+			}),
+		},
 	)
 }
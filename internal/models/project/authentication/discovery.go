@@ -0,0 +1,112 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The path appended to an issuer URL to get the location of its OpenID Provider Configuration document.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// The name of the environment variable that disables fetching discovery documents while planning,
+// e.g., when running in an environment without access to the OAuth providers.
+const SkipDiscoveryEnv = "DESCOPE_SKIP_OIDC_DISCOVERY"
+
+// Returns whether fetching discovery documents was disabled with the environment variable.
+func skipDiscovery() bool {
+	skip, _ := strconv.ParseBool(os.Getenv(SkipDiscoveryEnv))
+	return skip
+}
+
+// The fields we use from an OpenID Provider Configuration document.
+type discoveryDocument struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserInfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKsEndpoint          string   `json:"jwks_uri"`
+	GrantTypesSupported   []string `json:"grant_types_supported"`
+}
+
+// Returns the grant types supported by the OAuth provider that can also be used in Descope,
+// using the default value from the OpenID Connect Discovery specification if the document
+// doesn't list any.
+func (d *discoveryDocument) allowedGrantTypes() []string {
+	supported := d.GrantTypesSupported
+	if len(supported) == 0 {
+		supported = []string{"authorization_code", "implicit"}
+	}
+	result := []string{}
+	for _, grantType := range []string{"authorization_code", "implicit"} {
+		if slices.Contains(supported, grantType) {
+			result = append(result, grantType)
+		}
+	}
+	return result
+}
+
+// The HTTP client used for fetching discovery documents.
+var discoveryClient = &http.Client{Timeout: 15 * time.Second}
+
+// Caches the discovery documents so that each one is only fetched once per provider run.
+var discoveryCache sync.Map
+
+// Returns the URL of the discovery document, which is either set explicitly or derived from an issuer URL.
+func discoveryDocumentURL(value string) string {
+	if strings.Contains(value, "/.well-known/") {
+		return value
+	}
+	return strings.TrimSuffix(value, "/") + discoveryPath
+}
+
+// Fetches and parses the OpenID Provider Configuration document from the discovery URL.
+func fetchDiscoveryDocument(ctx context.Context, discoveryURL string) (*discoveryDocument, error) {
+	url := discoveryDocumentURL(discoveryURL)
+	if doc, ok := discoveryCache.Load(url); ok {
+		if d, ok := doc.(*discoveryDocument); ok {
+			return d, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid discovery URL: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := discoveryClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the discovery document from %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch the discovery document from %s: unexpected status code %d", url, res.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the discovery document from %s: %w", url, err)
+	}
+
+	doc := &discoveryDocument{}
+	if err := json.Unmarshal(body, doc); err != nil {
+		return nil, fmt.Errorf("the discovery document at %s is not valid JSON: %w", url, err)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" {
+		return nil, errors.New("the discovery document at " + url + " is missing the authorization_endpoint or token_endpoint fields")
+	}
+
+	discoveryCache.Store(url, doc)
+	return doc, nil
+}
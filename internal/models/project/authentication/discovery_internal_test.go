@@ -0,0 +1,113 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/strlistattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/types/valuelisttype"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOAuthDiscovery verifies that custom OAuth provider endpoints are filled from a discovery
+// document during planning, and that values set explicitly in the configuration take precedence.
+func TestOAuthDiscovery(t *testing.T) {
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+			"jwks_uri":               server.URL + "/jwks",
+			"grant_types_supported":  []string{"authorization_code", "refresh_token"},
+		})
+	}))
+	defer server.Close()
+
+	// unknown values are filled from the discovery document and explicit values are kept
+	m := testDiscoveryModel(server.URL)
+	m.TokenEndpoint = stringattr.Value("https://example.com/token")
+	diags := diag.Diagnostics{}
+	m.Modify(helpers.NewHandler(context.Background(), &diags), nil)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, server.URL, m.Issuer.ValueString())
+	assert.Equal(t, server.URL+"/authorize", m.AuthorizationEndpoint.ValueString())
+	assert.Equal(t, "https://example.com/token", m.TokenEndpoint.ValueString())
+	assert.Equal(t, server.URL+"/userinfo", m.UserInfoEndpoint.ValueString())
+	assert.Equal(t, server.URL+"/jwks", m.JWKsEndpoint.ValueString())
+	assert.True(t, m.AllowedGrantTypes.Equal(strlistattr.Value([]string{"authorization_code"})))
+	assert.Equal(t, 1, requests)
+
+	// the full document URL can be used as well, and the document is only fetched once
+	m = testDiscoveryModel(server.URL + "/.well-known/openid-configuration")
+	m.Modify(helpers.NewHandler(context.Background(), &diags), nil)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, server.URL+"/token", m.TokenEndpoint.ValueString())
+	assert.Equal(t, 1, requests)
+
+	// nothing is fetched when all values are known
+	m = testDiscoveryModel(server.URL + "/missing")
+	m.Issuer, m.AuthorizationEndpoint, m.TokenEndpoint = stringattr.Value(""), stringattr.Value(""), stringattr.Value("")
+	m.UserInfoEndpoint, m.JWKsEndpoint, m.AllowedGrantTypes = stringattr.Value(""), stringattr.Value(""), strlistattr.Empty()
+	m.Modify(helpers.NewHandler(context.Background(), &diags), nil)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	// a failure to fetch the discovery document is reported as an error
+	m = testDiscoveryModel(server.URL + "/missing")
+	m.Modify(helpers.NewHandler(context.Background(), &diags), nil)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "unexpected status code 404")
+
+	// the values in the state are kept with a warning when the discovery document can't be fetched
+	state := testDiscoveryModel(server.URL + "/missing")
+	state.Issuer, state.AuthorizationEndpoint, state.TokenEndpoint = stringattr.Value("https://example.com"), stringattr.Value("https://example.com/authorize"), stringattr.Value("https://example.com/token")
+	state.UserInfoEndpoint, state.JWKsEndpoint, state.AllowedGrantTypes = stringattr.Value(""), stringattr.Value("https://example.com/jwks"), strlistattr.Value([]string{"authorization_code"})
+	m = testDiscoveryModel(server.URL + "/missing")
+	diags = diag.Diagnostics{}
+	m.Modify(helpers.NewHandler(context.Background(), &diags), state)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, diags.Warnings(), 1)
+	assert.Contains(t, diags.Warnings()[0].Detail(), "unexpected status code 404")
+	assert.Equal(t, "https://example.com/authorize", m.AuthorizationEndpoint.ValueString())
+	assert.True(t, m.AllowedGrantTypes.Equal(state.AllowedGrantTypes))
+
+	// nothing is fetched when discovery is disabled, and the values in the state are kept
+	t.Setenv(SkipDiscoveryEnv, "true")
+	m = testDiscoveryModel(server.URL + "/missing")
+	diags = diag.Diagnostics{}
+	m.Modify(helpers.NewHandler(context.Background(), &diags), state)
+	require.Empty(t, diags)
+	assert.Equal(t, "https://example.com/token", m.TokenEndpoint.ValueString())
+	m = testDiscoveryModel(server.URL + "/other")
+	m.Modify(helpers.NewHandler(context.Background(), &diags), state)
+	require.Empty(t, diags)
+	assert.True(t, m.TokenEndpoint.IsUnknown())
+	assert.Equal(t, 1, requests)
+}
+
+func testDiscoveryModel(discoveryURL string) *OAuthProviderModel {
+	return &OAuthProviderModel{
+		DiscoveryURL:          stringattr.Value(discoveryURL),
+		Issuer:                types.StringUnknown(),
+		AuthorizationEndpoint: types.StringUnknown(),
+		TokenEndpoint:         types.StringUnknown(),
+		UserInfoEndpoint:      types.StringUnknown(),
+		JWKsEndpoint:          types.StringUnknown(),
+		AllowedGrantTypes:     valuelisttype.NewUnknownValue[types.String](context.Background()),
+	}
+}
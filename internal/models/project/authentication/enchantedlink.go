@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var EnchantedLinkValidator = objattr.NewValidator[EnchantedLinkModel]("must have valid message templates")
+
 var EnchantedLinkAttributes = map[string]schema.Attribute{
 	"disabled":        boolattr.Default(false),
 	"expiration_time": durationattr.Optional(durationattr.MinimumValue("1 minute")),
@@ -40,6 +42,10 @@ func (m *EnchantedLinkModel) SetValues(h *helpers.Handler, data map[string]any)
 	objattr.Set(&m.EmailService, data, helpers.RootKey, h)
 }
 
+func (m *EnchantedLinkModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.EnchantedLinkMessages)
+}
+
 func (m *EnchantedLinkModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var MagicLinkValidator = objattr.NewValidator[MagicLinkModel]("must have valid message templates")
+
 var MagicLinkAttributes = map[string]schema.Attribute{
 	"disabled":        boolattr.Default(false),
 	"expiration_time": durationattr.Optional(durationattr.MinimumValue("1 minute")),
@@ -44,6 +46,11 @@ func (m *MagicLinkModel) SetValues(h *helpers.Handler, data map[string]any) {
 	objattr.Set(&m.TextService, data, helpers.RootKey, h)
 }
 
+func (m *MagicLinkModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.MagicLinkMessages)
+	templates.ValidateTextService(h, m.TextService, templates.MagicLinkMessages)
+}
+
 func (m *MagicLinkModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 	objattr.UpdateReferences(&m.TextService, h)
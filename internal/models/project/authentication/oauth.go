@@ -12,6 +12,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strlistattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strmapattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,7 +23,7 @@ var OAuthValidator = objattr.NewValidator[OAuthModel]("must have a valid OAuth c
 var OAuthAttributes = map[string]schema.Attribute{
 	"disabled": boolattr.Default(false),
 	"system":   objattr.Default[OAuthSystemProvidersModel](nil, OAuthSystemProviderAttributes),
-	"custom":   mapattr.Default[OAuthProviderModel](nil, OAuthProviderAttributes, OAuthProviderValidator),
+	"custom":   mapattr.Default[OAuthProviderModel](nil, OAuthProviderAttributes, OAuthProviderValidator, OAuthProviderModifier),
 }
 
 type OAuthModel struct {
@@ -193,6 +194,7 @@ func validateSystemProvider(h *helpers.Handler, provider objattr.Type[OAuthProvi
 	ensureNoCustomProviderFields(h, m.Description, "description", name)
 	ensureNoCustomProviderFields(h, m.Logo, "logo", name)
 	ensureNoCustomProviderFields(h, m.Issuer, "issuer", name)
+	ensureNoCustomProviderFields(h, m.DiscoveryURL, "discovery_url", name)
 	ensureNoCustomProviderFields(h, m.AuthorizationEndpoint, "authorization_endpoint", name)
 	ensureNoCustomProviderFields(h, m.TokenEndpoint, "token_endpoint", name)
 	ensureNoCustomProviderFields(h, m.UserInfoEndpoint, "user_info_endpoint", name)
@@ -280,6 +282,8 @@ var systemClaimMapping = []string{"loginId", "username", "name", "email", "phone
 
 var OAuthProviderValidator = objattr.NewValidator[OAuthProviderModel]("must have a valid OAuth provider configuration")
 
+var OAuthProviderModifier = objattr.NewModifier[OAuthProviderModel]("fills the OAuth provider endpoints from its discovery document", objattr.ModifierAllowNullState)
+
 var OAuthProviderAttributes = map[string]schema.Attribute{
 	"disabled":                   boolattr.Default(false),
 	"client_id":                  stringattr.Optional(),
@@ -301,6 +305,7 @@ var OAuthProviderAttributes = map[string]schema.Attribute{
 	"description":            stringattr.Optional(),
 	"logo":                   stringattr.Optional(),
 	"issuer":                 stringattr.Optional(),
+	"discovery_url":          stringattr.Default(""),
 	"authorization_endpoint": stringattr.Optional(),
 	"token_endpoint":         stringattr.Optional(),
 	"user_info_endpoint":     stringattr.Optional(),
@@ -318,7 +323,7 @@ type AppleKeyGeneratorModel struct {
 var AppleKeyGeneratorModelAttributes = map[string]schema.Attribute{
 	"key_id":      stringattr.Required(),
 	"team_id":     stringattr.Required(),
-	"private_key": stringattr.SecretRequired(),
+	"private_key": stringattr.SecretRequired(keymaterial.P256PrivateKeyValidator),
 }
 
 func (m *AppleKeyGeneratorModel) Values(h *helpers.Handler) map[string]any {
@@ -351,6 +356,7 @@ type OAuthProviderModel struct {
 	Logo                    stringattr.Type                                 `tfsdk:"logo"`
 	AllowedGrantTypes       strlistattr.Type                                `tfsdk:"allowed_grant_types"`
 	Issuer                  stringattr.Type                                 `tfsdk:"issuer"`
+	DiscoveryURL            stringattr.Type                                 `tfsdk:"discovery_url"`
 	AuthorizationEndpoint   stringattr.Type                                 `tfsdk:"authorization_endpoint"`
 	TokenEndpoint           stringattr.Type                                 `tfsdk:"token_endpoint"`
 	UserInfoEndpoint        stringattr.Type                                 `tfsdk:"user_info_endpoint"`
@@ -436,6 +442,9 @@ func (m *OAuthProviderModel) SetValues(h *helpers.Handler, data map[string]any)
 	objattr.Set(&m.AppleKeyGenerator, data, "appleKeyGenerator", h)
 	objattr.Set(&m.NativeAppleKeyGenerator, data, "nativeAppleKeyGenerator", h)
 	strmapattr.Nil(&m.ClaimMapping, h) // XXX empty defaults are added by the backend, add parsing for refresh
+	if m.DiscoveryURL.IsNull() || m.DiscoveryURL.IsUnknown() {
+		m.DiscoveryURL = stringattr.Value("") // only used during planning and not sent to the backend
+	}
 }
 
 func (m *OAuthProviderModel) Validate(h *helpers.Handler) {
@@ -444,6 +453,66 @@ func (m *OAuthProviderModel) Validate(h *helpers.Handler) {
 	}
 }
 
+func (m *OAuthProviderModel) Modify(h *helpers.Handler, state *OAuthProviderModel) {
+	if m.DiscoveryURL.IsUnknown() || m.DiscoveryURL.ValueString() == "" {
+		return
+	}
+
+	// only fetch the discovery document if there are any endpoints that weren't set explicitly
+	if !helpers.HasUnknownValues(m.Issuer, m.AuthorizationEndpoint, m.TokenEndpoint, m.UserInfoEndpoint, m.JWKsEndpoint, m.AllowedGrantTypes) {
+		return
+	}
+
+	if skipDiscovery() {
+		m.setStateValues(state)
+		return
+	}
+
+	doc, err := fetchDiscoveryDocument(h.Ctx, m.DiscoveryURL.ValueString())
+	if err != nil && m.setStateValues(state) {
+		h.Warn("OIDC Discovery Failed", "Using the existing values of the OAuth provider as its discovery_url could not be fetched: %s", err.Error())
+		return
+	}
+	if err != nil {
+		h.Error("OIDC Discovery Failed", "Failed to configure the OAuth provider from its discovery_url: %s", err.Error())
+		return
+	}
+
+	setDiscoveredValue(&m.Issuer, doc.Issuer)
+	setDiscoveredValue(&m.AuthorizationEndpoint, doc.AuthorizationEndpoint)
+	setDiscoveredValue(&m.TokenEndpoint, doc.TokenEndpoint)
+	setDiscoveredValue(&m.UserInfoEndpoint, doc.UserInfoEndpoint)
+	setDiscoveredValue(&m.JWKsEndpoint, doc.JWKsEndpoint)
+	if grantTypes := doc.allowedGrantTypes(); m.AllowedGrantTypes.IsUnknown() && len(grantTypes) > 0 {
+		m.AllowedGrantTypes = strlistattr.Value(grantTypes)
+	}
+}
+
+// Fills the values that weren't set explicitly with the values in the state, for when the discovery
+// document isn't fetched. Returns false if the state doesn't have values from the same discovery_url.
+func (m *OAuthProviderModel) setStateValues(state *OAuthProviderModel) bool {
+	if state == nil || state.DiscoveryURL.ValueString() != m.DiscoveryURL.ValueString() || state.AuthorizationEndpoint.ValueString() == "" {
+		return false
+	}
+	setDiscoveredValue(&m.Issuer, state.Issuer.ValueString())
+	setDiscoveredValue(&m.AuthorizationEndpoint, state.AuthorizationEndpoint.ValueString())
+	setDiscoveredValue(&m.TokenEndpoint, state.TokenEndpoint.ValueString())
+	setDiscoveredValue(&m.UserInfoEndpoint, state.UserInfoEndpoint.ValueString())
+	setDiscoveredValue(&m.JWKsEndpoint, state.JWKsEndpoint.ValueString())
+	if m.AllowedGrantTypes.IsUnknown() && !state.AllowedGrantTypes.IsUnknown() {
+		m.AllowedGrantTypes = state.AllowedGrantTypes
+	}
+	return true
+}
+
+// Values that are unknown in the plan weren't set explicitly in the configuration, so we can
+// fill them with the values from the discovery document.
+func setDiscoveredValue(s *stringattr.Type, value string) {
+	if s.IsUnknown() && value != "" {
+		*s = stringattr.Value(value)
+	}
+}
+
 // Provider Token Management
 
 var OAuthProviderTokenManagementAttributes = map[string]schema.Attribute{}
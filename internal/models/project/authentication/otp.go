@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var OTPValidator = objattr.NewValidator[OTPModel]("must have valid message templates")
+
 var OTPAttributes = map[string]schema.Attribute{
 	"disabled":        boolattr.Default(false),
 	"domain":          stringattr.Optional(),
@@ -48,6 +50,12 @@ func (m *OTPModel) SetValues(h *helpers.Handler, data map[string]any) {
 	objattr.Set(&m.VoiceService, data, helpers.RootKey, h)
 }
 
+func (m *OTPModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.OTPMessages)
+	templates.ValidateTextService(h, m.TextService, templates.OTPMessages)
+	templates.ValidateVoiceService(h, m.VoiceService, templates.OTPMessages)
+}
+
 func (m *OTPModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 	objattr.UpdateReferences(&m.TextService, h)
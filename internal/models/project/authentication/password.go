@@ -10,9 +10,16 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/project/templates"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var PasswordValidator = objattr.NewValidator[PasswordModel]("must have a consistent password policy and valid message templates")
+
+var PasswordModifier = objattr.NewModifier[PasswordModel]("sets the password policy values from the preset", objattr.ModifierAllowNullState)
+
+const defaultTemporaryLockAttempts = 3
+
 var PasswordAttributes = map[string]schema.Attribute{
 	"disabled":                boolattr.Default(false),
 	"expiration":              boolattr.Optional(),
@@ -20,7 +27,7 @@ var PasswordAttributes = map[string]schema.Attribute{
 	"lock":                    boolattr.Optional(),
 	"lock_attempts":           intattr.Optional(int64validator.Between(2, 10)),
 	"temporary_lock":          boolattr.Default(false),
-	"temporary_lock_attempts": intattr.Default(3, int64validator.Between(1, 10)),
+	"temporary_lock_attempts": intattr.Default(defaultTemporaryLockAttempts, int64validator.Between(1, 10)),
 	"temporary_lock_duration": durationattr.Default("5 minutes", durationattr.MinimumValue("1 minute"), durationattr.MaximumValue("24 hours")),
 	"lowercase":               boolattr.Optional(),
 	"min_length":              intattr.Optional(int64validator.Between(4, 64)),
@@ -34,6 +41,7 @@ var PasswordAttributes = map[string]schema.Attribute{
 	"disallow_email_match":    boolattr.Optional(),
 	"enforce_strength":        stringattr.Default("none", stringvalidator.OneOf("none", "very_weak", "weak", "average", "strong", "very_strong")),
 	"mask_errors":             boolattr.Default(false),
+	"preset":                  stringattr.Default("", stringvalidator.OneOf(append([]string{""}, passwordPresetNames...)...)),
 	"email_service":           objattr.Optional[templates.EmailServiceModel](templates.EmailServiceAttributes, templates.EmailServiceValidator),
 }
 
@@ -58,6 +66,7 @@ type PasswordModel struct {
 	DisallowEmailMatch    boolattr.Type                             `tfsdk:"disallow_email_match"`
 	EnforceStrength       stringattr.Type                           `tfsdk:"enforce_strength"`
 	MaskErrors            boolattr.Type                             `tfsdk:"mask_errors"`
+	Preset                stringattr.Type                           `tfsdk:"preset"`
 	EmailService          objattr.Type[templates.EmailServiceModel] `tfsdk:"email_service"`
 }
 
@@ -119,9 +128,54 @@ func (m *PasswordModel) SetValues(h *helpers.Handler, data map[string]any) {
 		m.EnforceStrength = stringattr.Value(strengthStringFromScore(int(score)))
 	}
 	boolattr.Set(&m.MaskErrors, data, "maskError")
+	if m.Preset.IsNull() || m.Preset.IsUnknown() {
+		m.Preset = stringattr.Value("") // only used during planning and not sent to the backend
+	}
 	objattr.Set(&m.EmailService, data, helpers.RootKey, h)
 }
 
+func (m *PasswordModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.PasswordMessages)
+
+	// validate the policy that will actually be used, after the preset fills in the attributes
+	// that aren't set explicitly in the configuration
+	p := *m
+	p.applyPreset(attr.Value.IsNull)
+
+	if p.Expiration.ValueBool() && p.ExpirationWeeks.IsNull() {
+		h.Missing("The expiration_weeks attribute is required when expiration is enabled, as it determines when passwords expire")
+	}
+	if isFalse(p.Expiration) && isSet(p.ExpirationWeeks) {
+		h.Conflict("The expiration_weeks attribute has no effect when expiration is disabled, either remove it or set expiration to true")
+	}
+
+	if p.Lock.ValueBool() && p.LockAttempts.IsNull() {
+		h.Missing("The lock_attempts attribute is required when lock is enabled, as it determines after how many failed attempts accounts are locked")
+	}
+	if isFalse(p.Lock) && isSet(p.LockAttempts) {
+		h.Conflict("The lock_attempts attribute has no effect when lock is disabled, either remove it or set lock to true")
+	}
+
+	tempLockAttempts := p.TemporaryLockAttempts
+	if tempLockAttempts.IsNull() {
+		tempLockAttempts = intattr.Value(defaultTemporaryLockAttempts)
+	}
+	if p.Lock.ValueBool() && p.TemporaryLock.ValueBool() && isSet(p.LockAttempts) && isSet(tempLockAttempts) && p.LockAttempts.ValueInt64() <= tempLockAttempts.ValueInt64() {
+		h.Conflict("The lock_attempts value (%d) must be greater than the temporary_lock_attempts value (%d), otherwise accounts are locked permanently before they're ever locked temporarily", p.LockAttempts.ValueInt64(), tempLockAttempts.ValueInt64())
+	}
+
+	if p.Reuse.ValueBool() && p.ReuseAmount.IsNull() {
+		h.Missing("The reuse_amount attribute is required when reuse is enabled, as it determines how many previous passwords cannot be reused")
+	}
+	if isFalse(p.Reuse) && isSet(p.ReuseAmount) {
+		h.Conflict("The reuse_amount attribute has no effect when reuse is disabled, either remove it or set reuse to true")
+	}
+}
+
+func (m *PasswordModel) Modify(h *helpers.Handler, _ *PasswordModel) {
+	m.applyPreset(attr.Value.IsUnknown)
+}
+
 func (m *PasswordModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 }
@@ -146,3 +200,11 @@ func strengthStringFromScore(n int) string {
 	}
 	return "none"
 }
+
+func isSet(n intattr.Type) bool {
+	return !n.IsNull() && !n.IsUnknown()
+}
+
+func isFalse(b boolattr.Type) bool {
+	return !b.IsNull() && !b.IsUnknown() && !b.ValueBool()
+}
@@ -0,0 +1,163 @@
+package authentication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPasswordPreset verifies that a preset fills in the unknown values during planning and
+// that values set explicitly in the configuration take precedence.
+func TestPasswordPreset(t *testing.T) {
+	m := testPasswordModel(types.BoolUnknown(), types.Int64Unknown())
+	m.Preset = stringattr.Value("pci_dss_4")
+	m.MinLength = intattr.Value(16)
+	m.Modify(helpers.NewHandler(context.Background(), &diag.Diagnostics{}), nil)
+	assert.Equal(t, int64(16), m.MinLength.ValueInt64())
+	assert.True(t, m.Number.ValueBool())
+	assert.True(t, m.AnyLetter.ValueBool())
+	assert.False(t, m.Uppercase.ValueBool())
+	assert.True(t, m.Expiration.ValueBool())
+	assert.Equal(t, int64(12), m.ExpirationWeeks.ValueInt64())
+	assert.Equal(t, int64(10), m.LockAttempts.ValueInt64())
+	assert.Equal(t, int64(4), m.ReuseAmount.ValueInt64())
+
+	// the numeric values aren't set when their feature is disabled explicitly
+	m = testPasswordModel(types.BoolUnknown(), types.Int64Unknown())
+	m.Preset = stringattr.Value("pci_dss_4")
+	m.Expiration = boolattr.Value(false)
+	m.Modify(helpers.NewHandler(context.Background(), &diag.Diagnostics{}), nil)
+	assert.False(t, m.Expiration.ValueBool())
+	assert.True(t, m.ExpirationWeeks.IsUnknown())
+
+	// nothing is set without a preset
+	m = testPasswordModel(types.BoolUnknown(), types.Int64Unknown())
+	m.Modify(helpers.NewHandler(context.Background(), &diag.Diagnostics{}), nil)
+	assert.True(t, m.MinLength.IsUnknown())
+	assert.True(t, m.Lock.IsUnknown())
+}
+
+func TestPasswordValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify func(m *PasswordModel)
+		err    string
+	}{
+		"empty": {
+			modify: func(m *PasswordModel) {},
+		},
+		"expiration": {
+			modify: func(m *PasswordModel) { m.Expiration = boolattr.Value(true) },
+			err:    "expiration_weeks attribute is required when expiration is enabled",
+		},
+		"expiration weeks": {
+			modify: func(m *PasswordModel) {
+				m.Expiration = boolattr.Value(false)
+				m.ExpirationWeeks = intattr.Value(4)
+			},
+			err: "expiration_weeks attribute has no effect when expiration is disabled",
+		},
+		"lock": {
+			modify: func(m *PasswordModel) { m.Lock = boolattr.Value(true) },
+			err:    "lock_attempts attribute is required when lock is enabled",
+		},
+		"lock attempts": {
+			modify: func(m *PasswordModel) {
+				m.Lock = boolattr.Value(true)
+				m.LockAttempts = intattr.Value(5)
+				m.TemporaryLock = boolattr.Value(true)
+				m.TemporaryLockAttempts = intattr.Value(5)
+			},
+			err: "lock_attempts value (5) must be greater than the temporary_lock_attempts value (5)",
+		},
+		"lock attempts default": {
+			modify: func(m *PasswordModel) {
+				m.Lock = boolattr.Value(true)
+				m.LockAttempts = intattr.Value(3)
+				m.TemporaryLock = boolattr.Value(true)
+			},
+			err: "lock_attempts value (3) must be greater than the temporary_lock_attempts value (3)",
+		},
+		"lock attempts valid": {
+			modify: func(m *PasswordModel) {
+				m.Lock = boolattr.Value(true)
+				m.LockAttempts = intattr.Value(6)
+				m.TemporaryLock = boolattr.Value(true)
+				m.TemporaryLockAttempts = intattr.Value(5)
+			},
+		},
+		"reuse": {
+			modify: func(m *PasswordModel) { m.Reuse = boolattr.Value(true) },
+			err:    "reuse_amount attribute is required when reuse is enabled",
+		},
+		"reuse amount": {
+			modify: func(m *PasswordModel) { m.Reuse, m.ReuseAmount = boolattr.Value(false), intattr.Value(3) },
+			err:    "reuse_amount attribute has no effect when reuse is disabled",
+		},
+		"preset": {
+			modify: func(m *PasswordModel) { m.Preset = stringattr.Value("pci_dss_4") },
+		},
+		"preset override": {
+			modify: func(m *PasswordModel) {
+				m.Preset = stringattr.Value("pci_dss_4")
+				m.Reuse = boolattr.Value(false)
+			},
+		},
+		"preset conflict": {
+			modify: func(m *PasswordModel) {
+				m.Preset = stringattr.Value("nist_800_63b")
+				m.TemporaryLock = boolattr.Value(true)
+				m.TemporaryLockAttempts = intattr.Value(10)
+			},
+			err: "lock_attempts value (10) must be greater than the temporary_lock_attempts value (10)",
+		},
+		"unknown": {
+			modify: func(m *PasswordModel) {
+				m.Expiration = types.BoolUnknown()
+				m.ExpirationWeeks = types.Int64Unknown()
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := testPasswordModel(types.BoolNull(), types.Int64Null())
+			test.modify(m)
+			diags := diag.Diagnostics{}
+			m.Validate(helpers.NewHandler(context.Background(), &diags))
+			if test.err == "" {
+				require.False(t, diags.HasError(), "%v", diags)
+			} else {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Detail(), test.err)
+			}
+		})
+	}
+}
+
+func testPasswordModel(b boolattr.Type, n intattr.Type) *PasswordModel {
+	return &PasswordModel{
+		Expiration:            b,
+		ExpirationWeeks:       n,
+		Lock:                  b,
+		LockAttempts:          n,
+		TemporaryLock:         types.BoolNull(),
+		TemporaryLockAttempts: types.Int64Null(),
+		Lowercase:             b,
+		MinLength:             n,
+		NonAlphanumeric:       b,
+		Number:                b,
+		Reuse:                 b,
+		ReuseAmount:           n,
+		Uppercase:             b,
+		AnyLetter:             b,
+		DisallowEmailMatch:    b,
+		Preset:                types.StringNull(),
+	}
+}
@@ -0,0 +1,103 @@
+package authentication
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// The values that a password policy preset expands into. Attributes that are left as zero
+// values in a preset aren't set by it, e.g., the expiration weeks when expiration is disabled.
+type passwordPreset struct {
+	MinLength          int64
+	Lowercase          bool
+	Uppercase          bool
+	Number             bool
+	NonAlphanumeric    bool
+	AnyLetter          bool
+	Expiration         bool
+	ExpirationWeeks    int64
+	Lock               bool
+	LockAttempts       int64
+	Reuse              bool
+	ReuseAmount        int64
+	DisallowEmailMatch bool
+}
+
+var passwordPresets = map[string]passwordPreset{
+	// NIST SP 800-63B: a minimum length without composition rules or periodic expiration,
+	// rejecting passwords that contain context-specific words, and limiting failed attempts
+	"nist_800_63b": {
+		MinLength:          8,
+		Lock:               true,
+		LockAttempts:       10,
+		DisallowEmailMatch: true,
+	},
+	// PCI DSS v4.0 requirements 8.3.4 to 8.3.9: at least 12 characters with both letters
+	// and numbers, changed every 90 days, not reusing the last 4 passwords, and locking
+	// the account after at most 10 failed attempts
+	"pci_dss_4": {
+		MinLength:       12,
+		Number:          true,
+		AnyLetter:       true,
+		Expiration:      true,
+		ExpirationWeeks: 12,
+		Lock:            true,
+		LockAttempts:    10,
+		Reuse:           true,
+		ReuseAmount:     4,
+	},
+	// OWASP ASVS 4.0 level 2 section 2.1: at least 12 characters without composition rules
+	// or periodic expiration, rejecting passwords that match the user's email address
+	"owasp_asvs_l2": {
+		MinLength:          12,
+		Lock:               true,
+		LockAttempts:       10,
+		DisallowEmailMatch: true,
+	},
+}
+
+// The names of the password policy presets that can be used in the preset attribute.
+var passwordPresetNames = slices.Sorted(maps.Keys(passwordPresets))
+
+// Sets the values from the preset on the attributes for which the unset function returns true,
+// which is used with unknown values when planning and with null values when validating the
+// configuration, so that attributes that are set explicitly override the preset.
+func (m *PasswordModel) applyPreset(unset func(attr.Value) bool) {
+	if m.Preset.IsUnknown() || m.Preset.IsNull() {
+		return
+	}
+	preset, ok := passwordPresets[m.Preset.ValueString()]
+	if !ok {
+		return
+	}
+
+	// the numeric values are only set when the preset or an explicit attribute enables them
+	setInt := func(value *intattr.Type, n int64, enabled boolattr.Type) {
+		if n != 0 && enabled.ValueBool() && unset(*value) {
+			*value = intattr.Value(n)
+		}
+	}
+	setBool := func(value *boolattr.Type, b bool) {
+		if unset(*value) {
+			*value = boolattr.Value(b)
+		}
+	}
+
+	setInt(&m.MinLength, preset.MinLength, boolattr.Value(true))
+	setBool(&m.Lowercase, preset.Lowercase)
+	setBool(&m.Uppercase, preset.Uppercase)
+	setBool(&m.Number, preset.Number)
+	setBool(&m.NonAlphanumeric, preset.NonAlphanumeric)
+	setBool(&m.AnyLetter, preset.AnyLetter)
+	setBool(&m.Expiration, preset.Expiration)
+	setInt(&m.ExpirationWeeks, preset.ExpirationWeeks, m.Expiration)
+	setBool(&m.Lock, preset.Lock)
+	setInt(&m.LockAttempts, preset.LockAttempts, m.Lock)
+	setBool(&m.Reuse, preset.Reuse)
+	setInt(&m.ReuseAmount, preset.ReuseAmount, m.Reuse)
+	setBool(&m.DisallowEmailMatch, preset.DisallowEmailMatch)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var SSOValidator = objattr.NewValidator[SSOModel]("must have valid message templates")
+
 var SSOAttributes = map[string]schema.Attribute{
 	"disabled":                              boolattr.Default(false),
 	"merge_users":                           boolattr.Default(false),
@@ -89,6 +91,10 @@ func (m *SSOModel) SetValues(h *helpers.Handler, data map[string]any) {
 	objattr.Set(&m.EmailService, data, helpers.RootKey, h)
 }
 
+func (m *SSOModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.SSOMessages)
+}
+
 func (m *SSOModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strsetattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/authorization/fga"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/iancoleman/strcase"
 )
@@ -46,7 +47,9 @@ func (m *AuthorizationModel) Values(h *helpers.Handler) map[string]any {
 func (m *AuthorizationModel) SetValues(h *helpers.Handler, data map[string]any) {
 	listattr.SetMatchingNames(&m.Roles, data, "roles", "name", h)
 	listattr.SetMatchingNames(&m.Permissions, data, "permissions", "name", h)
-	stringattr.Set(&m.FGA, data, "fga", stringattr.SkipIfAlreadySet) // there might be formatting differences and we don't want to trigger inconsistency errors
+	if value, _ := data["fga"].(string); m.FGA.ValueString() == "" || fga.Drifted(m.FGA.ValueString(), value) {
+		stringattr.Set(&m.FGA, data, "fga") // the schema is kept as is when it only has formatting differences
+	}
 }
 
 func (m *AuthorizationModel) CollectReferences(h *helpers.Handler) {
@@ -60,11 +63,14 @@ func (m *AuthorizationModel) Validate(h *helpers.Handler) {
 		return // skip validation if there are unknown values
 	}
 
-	if fga := strings.TrimSpace(m.FGA.ValueString()); fga != "" && !strings.HasPrefix(fga, "model AuthZ") {
-		h.Invalid("The 'fga' attribute must start with 'model AuthZ', make sure you're using the schema from the code view in the FGA tab in the Descope console")
+	if schema := m.FGA.ValueString(); strings.TrimSpace(schema) != "" {
+		for _, err := range fga.Check(schema) {
+			h.Error("Invalid FGA Schema", "Line %d: %s", err.Line, err.Message)
+		}
 	}
 
 	permissions := map[string]int{}
+	permissionKeys := map[string]int{}
 	roleNames := map[string]int{}
 	roleKeys := map[string]int{}
 
@@ -99,12 +105,22 @@ func (m *AuthorizationModel) Validate(h *helpers.Handler) {
 		}
 	}
 
+	if !listattr.CollectKeys(h, permissionKeys, m.Permissions) {
+		h.Missing("The 'key' attribute must be set in all objects in the 'permissions' list")
+	}
+
 	for k, v := range permissions {
 		if v > 1 {
 			h.Error("Permission names must be unique", "The permission name '%s' is used %d times", k, v)
 		}
 	}
 
+	for k, v := range permissionKeys {
+		if v > 1 {
+			h.Error("Permission keys must be unique", "The permission key '%s' is used %d times", k, v)
+		}
+	}
+
 	for k, v := range roleNames {
 		if v > 1 {
 			h.Error("Role names must be unique", "The role name '%s' is used %d times", k, v)
@@ -133,5 +149,5 @@ func (m *AuthorizationModel) Modify(h *helpers.Handler, state *AuthorizationMode
 	}
 
 	listattr.ModifyMatchingKeysOrNames(h, &m.Roles, state.Roles)
-	listattr.ModifyMatchingNames(h, &m.Permissions, state.Permissions)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Permissions, state.Permissions)
 }
@@ -138,6 +138,14 @@ func TestAuthorization(t *testing.T) {
 				"authorization.permissions.0.description": "Allowed to build and sign applications",
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				authorization = {
+					fga = "model AuthZ 1.0\n\ntype doc\n  relation owner: user\n"
+				}
+			`),
+			ExpectError: regexp.MustCompile(`Line 4: .* unknown type 'user'`),
+		},
 		resource.TestStep{
 			Config: p.Config(`
 				authorization = {
@@ -0,0 +1,423 @@
+package fga
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// A Schema is an authorization model in the Descope FGA DSL, for example:
+//
+//	model AuthZ 1.0
+//
+//	type user
+//
+//	type group
+//	  relation member: user | group#member
+//
+//	type folder
+//	  relation owner: user | group#member
+//	  relation parent: folder
+//	  relation viewer: user:*
+//	  permission can_edit: owner | parent.can_edit
+//	  permission can_view: viewer | can_edit
+type Schema struct {
+	Version string
+	Types   []*Type
+}
+
+// A Type is a type definition in a schema.
+type Type struct {
+	Name        string
+	Line        int
+	Relations   []*Relation
+	Permissions []*Permission
+}
+
+// A Relation is a relation in a type definition, along with the targets that can be assigned to it.
+type Relation struct {
+	Name    string
+	Line    int
+	Targets []*Target
+}
+
+// A Target is a subject that can be assigned to a relation, either any object of a type (user),
+// all objects of a type at once (user:*), or the subjects in a relation of a type (group#member).
+type Target struct {
+	Type     string
+	Relation string
+	Wildcard bool
+}
+
+// A Permission is a permission in a type definition, which is computed from its expression.
+type Permission struct {
+	Name string
+	Line int
+	Expr *Expr
+}
+
+// An Op is the operator of a permission expression.
+type Op string
+
+const (
+	OpRef          Op = ""
+	OpUnion        Op = "|"
+	OpIntersection Op = "&"
+	OpExclusion    Op = "-"
+)
+
+// An Expr is a permission expression, which is either a reference to a relation or permission in the
+// same type (owner), a reference to a relation or permission of the objects in one of the type's
+// relations (parent.can_edit), or an operator that combines other expressions.
+type Expr struct {
+	Op       Op
+	Name     string
+	Via      string
+	Operands []*Expr
+}
+
+// An Error is a problem found in a schema, with the line number where it occurs.
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+const headerMessage = "The schema must start with 'model AuthZ 1.0', make sure you're using the schema from the code view in the FGA tab in the Descope console"
+
+var (
+	identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	versionRegexp    = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+)
+
+// Parses a schema in the Descope FGA DSL, returning the parsed schema along with any syntax
+// errors that were found. The schema is returned even when there are errors, with the
+// definitions that could be parsed.
+func Parse(text string) (*Schema, []*Error) {
+	p := &parser{schema: &Schema{}}
+	for i, line := range strings.Split(text, "\n") {
+		p.line = i + 1
+		p.parseLine(line)
+	}
+	if !p.header {
+		p.fail(1, headerMessage)
+	}
+	return p.schema, p.errors
+}
+
+// Parses and validates a schema in the Descope FGA DSL, returning all the errors that were found
+// in order of their line numbers.
+func Check(text string) []*Error {
+	schema, errs := Parse(text)
+	errs = append(errs, schema.Validate()...)
+	slices.SortStableFunc(errs, func(a, b *Error) int { return a.Line - b.Line })
+	return errs
+}
+
+// Reports whether a schema returned by the server is semantically different from the current
+// schema, ignoring differences in formatting, comments, and the order of definitions. Schemas
+// that cannot be parsed are never reported as drifted, as we can't tell whether they differ
+// in more than their formatting.
+func Drifted(current, actual string) bool {
+	current, actual = strings.TrimSpace(current), strings.TrimSpace(actual)
+	if current == actual {
+		return false
+	}
+	if current == "" || actual == "" {
+		return true
+	}
+	c, errs := Parse(current)
+	if len(errs) > 0 {
+		return false
+	}
+	a, errs := Parse(actual)
+	if len(errs) > 0 {
+		return false
+	}
+	return c.String() != a.String()
+}
+
+// Returns the type with the given name, or nil if there's no such type.
+func (s *Schema) Type(name string) *Type {
+	for _, t := range s.Types {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// Returns the relation with the given name, or nil if there's no such relation.
+func (t *Type) Relation(name string) *Relation {
+	for _, r := range t.Relations {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// Returns the permission with the given name, or nil if there's no such permission.
+func (t *Type) Permission(name string) *Permission {
+	for _, p := range t.Permissions {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Reports whether the type has a relation or permission with the given name.
+func (t *Type) Defines(name string) bool {
+	return t.Relation(name) != nil || t.Permission(name) != nil
+}
+
+// Parser
+
+type parser struct {
+	schema  *Schema
+	current *Type
+	header  bool
+	line    int
+	errors  []*Error
+}
+
+func (p *parser) fail(line int, format string, a ...any) {
+	p.errors = append(p.errors, &Error{Line: line, Message: fmt.Sprintf(format, a...)})
+}
+
+func (p *parser) parseLine(line string) {
+	if i := strings.Index(line, "//"); i >= 0 {
+		line = line[:i]
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+
+	keyword, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+
+	if keyword == "model" {
+		p.parseHeader(rest)
+		return
+	}
+	if !p.header {
+		p.fail(p.line, headerMessage)
+		p.header = true
+	}
+
+	switch keyword {
+	case "type":
+		p.parseType(rest)
+	case "relation":
+		p.parseRelation(rest)
+	case "permission":
+		p.parsePermission(rest)
+	default:
+		p.fail(p.line, "Unexpected '%s', expected a type, relation or permission definition", keyword)
+	}
+}
+
+func (p *parser) parseHeader(rest string) {
+	if p.header {
+		p.fail(p.line, "The model header must only appear once at the start of the schema")
+		return
+	}
+	p.header = true
+	name, version, _ := strings.Cut(rest, " ")
+	if name != "AuthZ" || !versionRegexp.MatchString(strings.TrimSpace(version)) {
+		p.fail(p.line, headerMessage)
+		return
+	}
+	p.schema.Version = strings.TrimSpace(version)
+}
+
+func (p *parser) parseType(rest string) {
+	if !identifierRegexp.MatchString(rest) {
+		p.fail(p.line, "Invalid type name '%s'", rest)
+		p.current = nil
+		return
+	}
+	p.current = &Type{Name: rest, Line: p.line}
+	p.schema.Types = append(p.schema.Types, p.current)
+}
+
+// Splits a relation or permission line into its name and definition, or returns false if
+// the line is invalid.
+func (p *parser) parseDefinition(kind, rest string) (string, string, bool) {
+	name, definition, found := strings.Cut(rest, ":")
+	name, definition = strings.TrimSpace(name), strings.TrimSpace(definition)
+	if !found || definition == "" {
+		p.fail(p.line, "The %s '%s' must have a definition after a ':' character", kind, name)
+		return "", "", false
+	}
+	if !identifierRegexp.MatchString(name) {
+		p.fail(p.line, "Invalid %s name '%s'", kind, name)
+		return "", "", false
+	}
+	if p.current == nil {
+		p.fail(p.line, "The %s '%s' must be defined inside a type definition", kind, name)
+		return "", "", false
+	}
+	return name, definition, true
+}
+
+func (p *parser) parseRelation(rest string) {
+	name, definition, ok := p.parseDefinition("relation", rest)
+	if !ok {
+		return
+	}
+	relation := &Relation{Name: name, Line: p.line}
+	for target := range strings.SplitSeq(definition, "|") {
+		target = strings.TrimSpace(target)
+		t := &Target{Type: target}
+		valid := true
+		if typ, rel, found := strings.Cut(target, "#"); found {
+			t.Type, t.Relation = typ, rel
+			valid = identifierRegexp.MatchString(rel)
+		} else if typ, found := strings.CutSuffix(target, ":*"); found {
+			t.Type, t.Wildcard = typ, true
+		}
+		if !valid || !identifierRegexp.MatchString(t.Type) {
+			p.fail(p.line, "Invalid target '%s' in relation '%s', expected a type name, optionally followed by '#relation' or ':*'", target, name)
+			return
+		}
+		relation.Targets = append(relation.Targets, t)
+	}
+	p.current.Relations = append(p.current.Relations, relation)
+}
+
+func (p *parser) parsePermission(rest string) {
+	name, definition, ok := p.parseDefinition("permission", rest)
+	if !ok {
+		return
+	}
+	tokens, err := tokenize(definition)
+	if err == nil {
+		e := &exprParser{tokens: tokens}
+		var expr *Expr
+		if expr, err = e.parse(); err == nil {
+			p.current.Permissions = append(p.current.Permissions, &Permission{Name: name, Line: p.line, Expr: expr})
+			return
+		}
+	}
+	p.fail(p.line, "Invalid expression in permission '%s': %s", name, err.Error())
+}
+
+// Expressions
+
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.IndexByte("|&-()", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case isIdentifierChar(c):
+			j := i
+			for j < len(s) && (isIdentifierChar(s[j]) || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character '%c'", c)
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// Parses permission expressions, where exclusion has the lowest precedence, followed by union
+// and then intersection, e.g., 'a | b & c - d' is parsed as '(a | (b & c)) - d'.
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (e *exprParser) parse() (*Expr, error) {
+	expr, err := e.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if e.pos < len(e.tokens) {
+		return nil, fmt.Errorf("unexpected '%s'", e.tokens[e.pos])
+	}
+	return expr, nil
+}
+
+var precedence = []Op{OpExclusion, OpUnion, OpIntersection}
+
+func (e *exprParser) parseBinary(level int) (*Expr, error) {
+	if level == len(precedence) {
+		return e.parsePrimary()
+	}
+	op := precedence[level]
+	left, err := e.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for e.pos < len(e.tokens) && e.tokens[e.pos] == string(op) {
+		e.pos++
+		right, err := e.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		if op == OpExclusion {
+			left = &Expr{Op: op, Operands: []*Expr{left, right}}
+			continue
+		}
+		// union and intersection are associative, so nested operands are flattened to make
+		// 'a | (b | c)' and '(a | b) | c' the same expression
+		operands := []*Expr{}
+		for _, o := range []*Expr{left, right} {
+			if o.Op == op {
+				operands = append(operands, o.Operands...)
+			} else {
+				operands = append(operands, o)
+			}
+		}
+		left = &Expr{Op: op, Operands: operands}
+	}
+	return left, nil
+}
+
+func (e *exprParser) parsePrimary() (*Expr, error) {
+	if e.pos == len(e.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	token := e.tokens[e.pos]
+	e.pos++
+
+	if token == "(" {
+		expr, err := e.parseBinary(0)
+		if err != nil {
+			return nil, err
+		}
+		if e.pos == len(e.tokens) || e.tokens[e.pos] != ")" {
+			return nil, fmt.Errorf("missing ')'")
+		}
+		e.pos++
+		return expr, nil
+	}
+
+	via, name, found := strings.Cut(token, ".")
+	if !found {
+		via, name = "", token
+	}
+	if !identifierRegexp.MatchString(name) || (found && !identifierRegexp.MatchString(via)) {
+		return nil, fmt.Errorf("unexpected '%s'", token)
+	}
+	return &Expr{Op: OpRef, Name: name, Via: via}, nil
+}
@@ -0,0 +1,193 @@
+package fga
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const schema = `model AuthZ 1.0
+
+type user
+
+type group
+  relation member: user | group#member
+
+// folders can be nested
+type folder
+  relation owner: user | group#member
+  relation parent: folder
+  relation viewer: user:*
+  relation banned: user
+  permission can_edit: owner | parent.can_edit
+  permission can_view: (viewer | can_edit) - banned
+`
+
+func TestParse(t *testing.T) {
+	s, errs := Parse(schema)
+	require.Empty(t, errs)
+	assert.Equal(t, "1.0", s.Version)
+	require.Len(t, s.Types, 3)
+
+	folder := s.Type("folder")
+	require.NotNil(t, folder)
+	assert.Equal(t, 9, folder.Line)
+	assert.Equal(t, []*Target{{Type: "user"}, {Type: "group", Relation: "member"}}, folder.Relation("owner").Targets)
+	assert.Equal(t, []*Target{{Type: "user", Wildcard: true}}, folder.Relation("viewer").Targets)
+
+	view := folder.Permission("can_view")
+	require.NotNil(t, view)
+	assert.Equal(t, OpExclusion, view.Expr.Op)
+	assert.Equal(t, "(can_edit | viewer) - banned", view.Expr.String())
+	assert.Equal(t, "owner | parent.can_edit", folder.Permission("can_edit").Expr.String())
+
+	assert.Empty(t, s.Validate())
+	assert.Empty(t, Check(schema))
+}
+
+func TestParseExpressions(t *testing.T) {
+	parse := func(definition string) string {
+		tokens, err := tokenize(definition)
+		require.NoError(t, err)
+		e := &exprParser{tokens: tokens}
+		expr, err := e.parse()
+		require.NoError(t, err)
+		return expr.String()
+	}
+
+	// nested unions and intersections are flattened regardless of grouping
+	assert.Equal(t, "a | b | c", parse("a | (b | c)"))
+	assert.Equal(t, parse("a | (b | c)"), parse("(a | b) | c"))
+	assert.Equal(t, parse("(c & a) & b"), parse("a & (b & c)"))
+	assert.Equal(t, "(a & b) | c", parse("c | a & b"))
+
+	// exclusion is not associative so its operands are kept in order
+	assert.Equal(t, "(a - b) - c", parse("a - b - c"))
+	assert.Equal(t, "a - (b - c)", parse("a - (b - c)"))
+
+	// the exclusion operator doesn't need to be surrounded by spaces
+	assert.Equal(t, "viewer - banned", parse("viewer-banned"))
+	assert.Equal(t, "parent.can_view - banned", parse("parent.can_view-banned"))
+}
+
+func TestParseErrors(t *testing.T) {
+	errs := Check("type user\n")
+	require.Len(t, errs, 1)
+	assert.Equal(t, 1, errs[0].Line)
+	assert.Contains(t, errs[0].Message, "must start with 'model AuthZ 1.0'")
+
+	errs = Check(`model AuthZ 1.0
+relation owner: user
+type user
+  relation friend user
+  relation enemy: user#
+  permission can_view: friend |
+  permission can_edit: (friend
+  resource foo
+`)
+	lines := []int{}
+	for _, err := range errs {
+		lines = append(lines, err.Line)
+	}
+	assert.Equal(t, []int{2, 4, 5, 6, 7, 8}, lines)
+	assert.Contains(t, errs[0].Message, "must be defined inside a type definition")
+	assert.Contains(t, errs[3].Message, "unexpected end of expression")
+	assert.Contains(t, errs[4].Message, "missing ')'")
+
+	// identifiers can't contain '-' since it's the exclusion operator in expressions
+	errs = Check(`model AuthZ 1.0
+type user
+type doc
+  relation can-edit: user
+  relation editor: user
+  relation banned: user
+  permission can_edit: editor-banned
+`)
+	require.Len(t, errs, 1)
+	assert.Equal(t, 4, errs[0].Line)
+	assert.Contains(t, errs[0].Message, "Invalid relation name 'can-edit'")
+}
+
+func TestValidate(t *testing.T) {
+	errs := Check(`model AuthZ 1.0
+
+type user
+
+type user
+
+type doc
+  relation owner: user | team
+  relation editor: user | group#admin
+  relation owner: user
+  relation parent: doc
+  permission can_view: owner | viewer
+  permission can_edit: parent.can_delete | owner
+  permission can_share: owner.can_view
+  permission can_read: can_write | owner
+  permission can_write: can_read & editor
+  permission can_list: parent.can_list
+`)
+	messages := map[int]string{}
+	for _, err := range errs {
+		messages[err.Line] = err.Message
+	}
+	assert.Len(t, errs, 8)
+	assert.Contains(t, messages[5], "The type 'user' is already defined on line 3")
+	assert.Contains(t, messages[8], "unknown type 'team'")
+	assert.Contains(t, messages[9], "references an unknown type 'group'")
+	assert.Contains(t, messages[10], "The relation 'owner' is already defined in type 'doc' on line 8")
+	assert.Contains(t, messages[12], "unknown relation or permission 'viewer'")
+	assert.Contains(t, messages[13], "none of the types in relation 'parent' (doc) define 'can_delete'")
+	assert.Contains(t, messages[14], "none of the types in relation 'owner' (user) define 'can_view'")
+	assert.Contains(t, messages[15], "depends on itself: can_read -> can_write -> can_read")
+}
+
+func TestDrifted(t *testing.T) {
+	// formatting, comments and ordering differences aren't considered drift
+	formatted := `model AuthZ 1.0
+type folder
+  relation viewer: user:*
+  relation owner: group#member | user
+  relation parent: folder
+  relation banned: user
+  permission can_view: (can_edit|viewer)-banned
+  permission can_edit: parent.can_edit | owner
+type group
+  relation member: group#member | user
+type user`
+	assert.False(t, Drifted(schema, formatted))
+	assert.False(t, Drifted(schema, "\n"+schema+"\n\n"))
+
+	// semantic differences are considered drift
+	assert.True(t, Drifted(schema, formatted+"\ntype team"))
+	assert.True(t, Drifted(schema, ""))
+	assert.True(t, Drifted("", schema))
+
+	// schemas that can't be parsed are kept as is unless the schema was removed
+	assert.False(t, Drifted(schema, "model AuthZ 1.0\ntype user\n  definition foo"))
+	assert.False(t, Drifted("", ""))
+}
+
+func TestValidateRelation(t *testing.T) {
+	s, errs := Parse(schema)
+	require.Empty(t, errs)
+
+	assert.NoError(t, s.ValidateRelation("folder", "owner", "user", "alice"))
+	assert.NoError(t, s.ValidateRelation("folder", "owner", "group", "platform#member"))
+	assert.NoError(t, s.ValidateRelation("folder", "viewer", "user", "*"))
+	assert.NoError(t, s.ValidateRelation("group", "member", "group", "platform#member"))
+
+	assert.ErrorContains(t, s.ValidateRelation("doc", "owner", "user", "alice"), "doesn't define a type named 'doc'")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "editor", "user", "alice"), "doesn't define a relation named 'editor'")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "can_view", "user", "alice"), "'can_view' is a permission")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "parent", "user", "alice"), "expected one of: folder")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "viewer", "user", "alice"), "expected one of: user:*")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "banned", "user", "*"), "doesn't accept the target '*' of type 'user'")
+
+	// targets of a type that's only allowed with a relation must specify that relation
+	assert.ErrorContains(t, s.ValidateRelation("folder", "owner", "group", "platform"), "expected one of: user, group#member")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "owner", "group", "platform#admin"), "expected one of: user, group#member")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "owner", "group", "*"), "expected one of: user, group#member")
+	assert.ErrorContains(t, s.ValidateRelation("folder", "owner", "user", "alice#member"), "expected one of: user, group#member")
+}
@@ -0,0 +1,93 @@
+package fga
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Returns the schema in a canonical format, where types, relations, permissions and relation
+// targets are sorted by name, and the operands of unions and intersections are sorted as
+// well, so that schemas that only differ in formatting have the same canonical format.
+func (s *Schema) String() string {
+	var b strings.Builder
+	b.WriteString("model AuthZ " + s.Version + "\n")
+
+	types := slices.Clone(s.Types)
+	slices.SortStableFunc(types, func(a, b *Type) int { return strings.Compare(a.Name, b.Name) })
+	for _, t := range types {
+		b.WriteString("\ntype " + t.Name + "\n")
+
+		relations := slices.Clone(t.Relations)
+		slices.SortStableFunc(relations, func(a, b *Relation) int { return strings.Compare(a.Name, b.Name) })
+		for _, r := range relations {
+			targets := []string{}
+			for _, target := range r.Targets {
+				targets = append(targets, target.String())
+			}
+			slices.Sort(targets)
+			b.WriteString("  relation " + r.Name + ": " + strings.Join(targets, " | ") + "\n")
+		}
+
+		permissions := slices.Clone(t.Permissions)
+		slices.SortStableFunc(permissions, func(a, b *Permission) int { return strings.Compare(a.Name, b.Name) })
+		for _, p := range permissions {
+			b.WriteString("  permission " + p.Name + ": " + p.Expr.String() + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+func (t *Target) String() string {
+	switch {
+	case t.Relation != "":
+		return t.Type + "#" + t.Relation
+	case t.Wildcard:
+		return t.Type + ":*"
+	default:
+		return t.Type
+	}
+}
+
+// Returns the expression in a canonical format, with parentheses around any nested operators.
+func (e *Expr) String() string {
+	if e.Op == OpRef {
+		if e.Via != "" {
+			return e.Via + "." + e.Name
+		}
+		return e.Name
+	}
+
+	operands := []string{}
+	for _, o := range e.Operands {
+		if o.Op == OpRef {
+			operands = append(operands, o.String())
+		} else {
+			operands = append(operands, "("+o.String()+")")
+		}
+	}
+	if e.Op != OpExclusion {
+		slices.Sort(operands)
+	}
+	return strings.Join(operands, " "+string(e.Op)+" ")
+}
+
+// Returns all the references to relations and permissions in the expression.
+func (e *Expr) refs() iter.Seq[*Expr] {
+	return func(yield func(*Expr) bool) {
+		e.walk(yield)
+	}
+}
+
+func (e *Expr) walk(yield func(*Expr) bool) bool {
+	if e.Op == OpRef {
+		return yield(e)
+	}
+	for _, o := range e.Operands {
+		if !o.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
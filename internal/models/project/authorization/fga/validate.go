@@ -0,0 +1,180 @@
+package fga
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validates the definitions in a schema, returning errors for duplicate names, references to
+// types, relations or permissions that don't exist, and permissions that depend on themselves.
+func (s *Schema) Validate() []*Error {
+	v := &validator{schema: s, types: map[string]*Type{}}
+	for _, t := range s.Types {
+		if prev, ok := v.types[t.Name]; ok {
+			v.fail(t.Line, "The type '%s' is already defined on line %d", t.Name, prev.Line)
+			continue
+		}
+		v.types[t.Name] = t
+	}
+	for _, t := range s.Types {
+		if v.types[t.Name] == t {
+			v.validateType(t)
+		}
+	}
+	return v.errors
+}
+
+type validator struct {
+	schema *Schema
+	types  map[string]*Type
+	errors []*Error
+}
+
+func (v *validator) fail(line int, format string, a ...any) {
+	v.errors = append(v.errors, &Error{Line: line, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *validator) validateType(t *Type) {
+	names := map[string]int{}
+	define := func(kind, name string, line int) {
+		if prev, ok := names[name]; ok {
+			v.fail(line, "The %s '%s' is already defined in type '%s' on line %d", kind, name, t.Name, prev)
+		} else {
+			names[name] = line
+		}
+	}
+
+	for _, r := range t.Relations {
+		define("relation", r.Name, r.Line)
+		for _, target := range r.Targets {
+			v.validateTarget(t, r, target)
+		}
+	}
+
+	for _, p := range t.Permissions {
+		define("permission", p.Name, p.Line)
+		for ref := range p.Expr.refs() {
+			v.validateRef(t, p, ref)
+		}
+	}
+
+	v.validateCycles(t)
+}
+
+func (v *validator) validateTarget(t *Type, r *Relation, target *Target) {
+	other := v.types[target.Type]
+	if other == nil {
+		v.fail(r.Line, "The relation '%s' in type '%s' references an unknown type '%s'", r.Name, t.Name, target.Type)
+	} else if target.Relation != "" && !other.Defines(target.Relation) {
+		v.fail(r.Line, "The relation '%s' in type '%s' references '%s#%s' but type '%s' has no relation or permission named '%s'", r.Name, t.Name, target.Type, target.Relation, target.Type, target.Relation)
+	}
+}
+
+func (v *validator) validateRef(t *Type, p *Permission, ref *Expr) {
+	if ref.Via == "" {
+		if !t.Defines(ref.Name) {
+			v.fail(p.Line, "The permission '%s' in type '%s' references an unknown relation or permission '%s'", p.Name, t.Name, ref.Name)
+		}
+		return
+	}
+
+	r := t.Relation(ref.Via)
+	if r == nil {
+		v.fail(p.Line, "The permission '%s' in type '%s' references '%s.%s' but '%s' is not a relation in type '%s'", p.Name, t.Name, ref.Via, ref.Name, ref.Via, t.Name)
+		return
+	}
+
+	var names []string
+	for _, target := range r.Targets {
+		if other := v.types[target.Type]; other != nil {
+			if other.Defines(ref.Name) {
+				return
+			}
+			names = append(names, other.Name)
+		}
+	}
+	if len(names) > 0 {
+		v.fail(p.Line, "The permission '%s' in type '%s' references '%s.%s' but none of the types in relation '%s' (%s) define '%s'", p.Name, t.Name, ref.Via, ref.Name, ref.Via, strings.Join(names, ", "), ref.Name)
+	}
+}
+
+// Reports permissions that depend on themselves through references to other permissions in the
+// same type. References through relations (parent.can_view) aren't considered, as they refer
+// to the permissions of other objects and are commonly used for hierarchies.
+func (v *validator) validateCycles(t *Type) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+
+	var visit func(p *Permission, path []string)
+	visit = func(p *Permission, path []string) {
+		state[p.Name] = visiting
+		path = append(path, p.Name)
+		for ref := range p.Expr.refs() {
+			next := t.Permission(ref.Name)
+			if ref.Via != "" || next == nil {
+				continue
+			}
+			switch state[next.Name] {
+			case visiting:
+				cycle := append(slices.Clone(path[slices.Index(path, next.Name):]), next.Name)
+				v.fail(next.Line, "The permission '%s' in type '%s' depends on itself: %s", next.Name, t.Name, strings.Join(cycle, " -> "))
+			case 0:
+				visit(next, path)
+			}
+		}
+		state[p.Name] = visited
+	}
+
+	for _, p := range t.Permissions {
+		if state[p.Name] == 0 {
+			visit(p, nil)
+		}
+	}
+}
+
+// Validates that a relation tuple can be created with the schema, i.e., that the resource type
+// declares the relation and that the relation accepts targets of the target type. Wildcard
+// targets (*) are only accepted when the relation allows all objects of the target type, and
+// targets with a relation (platform#member) only when the relation allows that set of subjects.
+func (s *Schema) ValidateRelation(resourceType, relation, targetType, target string) error {
+	t := s.Type(resourceType)
+	if t == nil {
+		return fmt.Errorf("the FGA schema doesn't define a type named '%s'", resourceType)
+	}
+	r := t.Relation(relation)
+	if r == nil {
+		if t.Permission(relation) != nil {
+			return fmt.Errorf("'%s' is a permission of type '%s' and relations can't be created for it", relation, resourceType)
+		}
+		return fmt.Errorf("the type '%s' doesn't define a relation named '%s'", resourceType, relation)
+	}
+	id, targetRelation, _ := strings.Cut(target, "#")
+	for _, rt := range r.Targets {
+		if rt.Type != targetType {
+			continue
+		}
+		switch {
+		case rt.Wildcard:
+			if target == "*" {
+				return nil
+			}
+		case rt.Relation != "":
+			if id != "*" && targetRelation == rt.Relation {
+				return nil
+			}
+		default:
+			if id != "*" && targetRelation == "" {
+				return nil
+			}
+		}
+	}
+	targets := []string{}
+	for _, rt := range r.Targets {
+		targets = append(targets, rt.String())
+	}
+	return fmt.Errorf("the relation '%s' of type '%s' doesn't accept the target '%s' of type '%s', expected one of: %s", relation, resourceType, target, targetType, strings.Join(targets, ", "))
+}
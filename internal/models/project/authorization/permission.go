@@ -9,12 +9,14 @@ import (
 
 var PermissionAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringvalidator.LengthAtMost(100)),
 	"description": stringattr.Optional(stringattr.StandardLenValidator),
 }
 
 type PermissionModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 }
@@ -35,6 +37,10 @@ func (m *PermissionModel) SetValues(h *helpers.Handler, data map[string]any) {
 
 // Matching
 
+func (m *PermissionModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *PermissionModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var AlloyAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var AlloyAttributes = map[string]schema.Attribute{
 
 type AlloyModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -62,6 +64,10 @@ func (m *AlloyModel) SetConfigurationValues(c map[string]any, h *helpers.Handler
 
 // Matching
 
+func (m *AlloyModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AlloyModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var ArkoseAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -23,6 +24,7 @@ var ArkoseAttributes = map[string]schema.Attribute{
 
 type ArkoseModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -66,6 +68,10 @@ func (m *ArkoseModel) SetConfigurationValues(c map[string]any, h *helpers.Handle
 
 // Matching
 
+func (m *ArkoseModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *ArkoseModel) GetName() stringattr.Type {
 	return m.Name
 }
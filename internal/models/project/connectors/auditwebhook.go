@@ -14,30 +14,34 @@ import (
 
 var AuditWebhookAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"base_url":       stringattr.Required(),
-	"authentication": objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":        strmapattr.Default(),
-	"hmac_secret":    stringattr.SecretOptional(),
-	"insecure":       boolattr.Default(false),
-	"audit_filters":  listattr.Default[AuditFilterFieldModel](AuditFilterFieldAttributes),
+	"base_url":          stringattr.Required(),
+	"authentication":    objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
+	"headers":           strmapattr.Default(HeadersValidator),
+	"sensitive_headers": strmapattr.SecretDefault(SensitiveHeadersValidator),
+	"hmac_secret":       stringattr.SecretOptional(),
+	"insecure":          boolattr.Default(false),
+	"audit_filters":     listattr.Default[AuditFilterFieldModel](AuditFilterFieldAttributes),
 }
 
 // Model
 
 type AuditWebhookModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
-	BaseURL        stringattr.Type                      `tfsdk:"base_url"`
-	Authentication objattr.Type[HTTPAuthFieldModel]     `tfsdk:"authentication"`
-	Headers        strmapattr.Type                      `tfsdk:"headers"`
-	HMACSecret     stringattr.Type                      `tfsdk:"hmac_secret"`
-	Insecure       boolattr.Type                        `tfsdk:"insecure"`
-	AuditFilters   listattr.Type[AuditFilterFieldModel] `tfsdk:"audit_filters"`
+	BaseURL          stringattr.Type                      `tfsdk:"base_url"`
+	Authentication   objattr.Type[HTTPAuthFieldModel]     `tfsdk:"authentication"`
+	Headers          strmapattr.Type                      `tfsdk:"headers"`
+	SensitiveHeaders strmapattr.Type                      `tfsdk:"sensitive_headers"`
+	HMACSecret       stringattr.Type                      `tfsdk:"hmac_secret"`
+	Insecure         boolattr.Type                        `tfsdk:"insecure"`
+	AuditFilters     listattr.Type[AuditFilterFieldModel] `tfsdk:"audit_filters"`
 }
 
 func (m *AuditWebhookModel) Values(h *helpers.Handler) map[string]any {
@@ -61,6 +65,7 @@ func (m *AuditWebhookModel) ConfigurationValues(h *helpers.Handler) map[string]a
 	stringattr.Get(m.BaseURL, c, "baseUrl")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	stringattr.Get(m.HMACSecret, c, "hmacSecret")
 	boolattr.Get(m.Insecure, c, "insecure")
 	listattr.Get(m.AuditFilters, c, "auditFilters", h)
@@ -71,6 +76,7 @@ func (m *AuditWebhookModel) SetConfigurationValues(c map[string]any, h *helpers.
 	stringattr.Set(&m.BaseURL, c, "baseUrl")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	stringattr.Nil(&m.HMACSecret)
 	boolattr.Set(&m.Insecure, c, "insecure")
 	listattr.Set(&m.AuditFilters, c, "auditFilters", h)
@@ -78,6 +84,10 @@ func (m *AuditWebhookModel) SetConfigurationValues(c map[string]any, h *helpers.
 
 // Matching
 
+func (m *AuditWebhookModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AuditWebhookModel) GetName() stringattr.Type {
 	return m.Name
 }
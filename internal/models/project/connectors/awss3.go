@@ -16,6 +16,7 @@ var AWSS3Validator = objattr.NewValidator[AWSS3Model]("must have a valid configu
 
 var AWSS3Attributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -36,6 +37,7 @@ var AWSS3Attributes = map[string]schema.Attribute{
 
 type AWSS3Model struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -130,6 +132,10 @@ func (m *AWSS3Model) SetConfigurationValues(c map[string]any, h *helpers.Handler
 
 // Matching
 
+func (m *AWSS3Model) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AWSS3Model) GetName() stringattr.Type {
 	return m.Name
 }
@@ -14,6 +14,7 @@ var AWSSESEmailValidationValidator = objattr.NewValidator[AWSSESEmailValidationM
 
 var AWSSESEmailValidationAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var AWSSESEmailValidationAttributes = map[string]schema.Attribute{
 
 type AWSSESEmailValidationModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -112,6 +114,10 @@ func (m *AWSSESEmailValidationModel) SetConfigurationValues(c map[string]any, h
 
 // Matching
 
+func (m *AWSSESEmailValidationModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AWSSESEmailValidationModel) GetName() stringattr.Type {
 	return m.Name
 }
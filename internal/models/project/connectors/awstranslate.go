@@ -10,6 +10,7 @@ import (
 
 var AWSTranslateAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -23,6 +24,7 @@ var AWSTranslateAttributes = map[string]schema.Attribute{
 
 type AWSTranslateModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -66,6 +68,10 @@ func (m *AWSTranslateModel) SetConfigurationValues(c map[string]any, h *helpers.
 
 // Matching
 
+func (m *AWSTranslateModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *AWSTranslateModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
-var ConnectorsValidator = objattr.NewValidator[ConnectorsModel]("must have unique connector names")
+var ConnectorsValidator = objattr.NewValidator[ConnectorsModel]("must have unique connector names and keys")
 
 var ConnectorsModifier = objattr.NewModifier[ConnectorsModel]("maintains connector identifiers between plan changes")
 
@@ -400,160 +400,239 @@ func (m *ConnectorsModel) CollectReferences(h *helpers.Handler) {
 
 func (m *ConnectorsModel) Validate(h *helpers.Handler) {
 	names := map[string]int{}
+	keys := map[string]int{}
 	addConnectorNames(h, names, m.AbuseIPDB)
+	addConnectorKeys(h, keys, "abuseipdb", m.AbuseIPDB)
 	addConnectorNames(h, names, m.Alloy)
+	addConnectorKeys(h, keys, "alloy", m.Alloy)
 	addConnectorNames(h, names, m.Amplitude)
+	addConnectorKeys(h, keys, "amplitude", m.Amplitude)
 	addConnectorNames(h, names, m.Arkose)
+	addConnectorKeys(h, keys, "arkose", m.Arkose)
 	addConnectorNames(h, names, m.AuditWebhook)
+	addConnectorKeys(h, keys, "audit_webhook", m.AuditWebhook)
 	addConnectorNames(h, names, m.AWSS3)
+	addConnectorKeys(h, keys, "aws_s3", m.AWSS3)
 	addConnectorNames(h, names, m.AWSSESEmailValidation)
+	addConnectorKeys(h, keys, "aws_ses_email_validation", m.AWSSESEmailValidation)
 	addConnectorNames(h, names, m.AWSTranslate)
+	addConnectorKeys(h, keys, "aws_translate", m.AWSTranslate)
 	addConnectorNames(h, names, m.Bitsight)
+	addConnectorKeys(h, keys, "bitsight", m.Bitsight)
 	addConnectorNames(h, names, m.Coralogix)
+	addConnectorKeys(h, keys, "coralogix", m.Coralogix)
 	addConnectorNames(h, names, m.Cribl)
+	addConnectorKeys(h, keys, "cribl", m.Cribl)
 	addConnectorNames(h, names, m.Darwinium)
+	addConnectorKeys(h, keys, "darwinium", m.Darwinium)
 	addConnectorNames(h, names, m.Datadog)
+	addConnectorKeys(h, keys, "datadog", m.Datadog)
 	addConnectorNames(h, names, m.DevRevGrow)
+	addConnectorKeys(h, keys, "devrev_grow", m.DevRevGrow)
 	addConnectorNames(h, names, m.Docebo)
+	addConnectorKeys(h, keys, "docebo", m.Docebo)
 	addConnectorNames(h, names, m.EightByEightViber)
+	addConnectorKeys(h, keys, "eight_by_eight_viber", m.EightByEightViber)
 	addConnectorNames(h, names, m.EightByEightWhatsapp)
+	addConnectorKeys(h, keys, "eight_by_eight_whatsapp", m.EightByEightWhatsapp)
 	addConnectorNames(h, names, m.Elephant)
+	addConnectorKeys(h, keys, "elephant", m.Elephant)
 	addConnectorNames(h, names, m.ExternalTokenHTTP)
+	addConnectorKeys(h, keys, "external_token_http", m.ExternalTokenHTTP)
 	addConnectorNames(h, names, m.Fingerprint)
+	addConnectorKeys(h, keys, "fingerprint", m.Fingerprint)
 	addConnectorNames(h, names, m.FingerprintDescope)
+	addConnectorKeys(h, keys, "fingerprint_descope", m.FingerprintDescope)
 	addConnectorNames(h, names, m.FirebaseAdmin)
+	addConnectorKeys(h, keys, "firebase_admin", m.FirebaseAdmin)
 	addConnectorNames(h, names, m.Forter)
+	addConnectorKeys(h, keys, "forter", m.Forter)
 	addConnectorNames(h, names, m.GenericEmailGateway)
+	addConnectorKeys(h, keys, "generic_email_gateway", m.GenericEmailGateway)
 	addConnectorNames(h, names, m.GenericSMSGateway)
+	addConnectorKeys(h, keys, "generic_sms_gateway", m.GenericSMSGateway)
 	addConnectorNames(h, names, m.GoogleCloudTranslation)
+	addConnectorKeys(h, keys, "google_cloud_translation", m.GoogleCloudTranslation)
 	addConnectorNames(h, names, m.GoogleMapsPlaces)
+	addConnectorKeys(h, keys, "google_maps_places", m.GoogleMapsPlaces)
 	addConnectorNames(h, names, m.GoogleCloudLogging)
+	addConnectorKeys(h, keys, "google_cloud_logging", m.GoogleCloudLogging)
 	addConnectorNames(h, names, m.Groundcover)
+	addConnectorKeys(h, keys, "groundcover", m.Groundcover)
 	addConnectorNames(h, names, m.HCaptcha)
+	addConnectorKeys(h, keys, "hcaptcha", m.HCaptcha)
 	addConnectorNames(h, names, m.HIBP)
+	addConnectorKeys(h, keys, "hibp", m.HIBP)
 	addConnectorNames(h, names, m.HTTP)
+	addConnectorKeys(h, keys, "http", m.HTTP)
 	addConnectorNames(h, names, m.HubSpot)
+	addConnectorKeys(h, keys, "hubspot", m.HubSpot)
 	addConnectorNames(h, names, m.Incode)
+	addConnectorKeys(h, keys, "incode", m.Incode)
 	addConnectorNames(h, names, m.Intercom)
+	addConnectorKeys(h, keys, "intercom", m.Intercom)
 	addConnectorNames(h, names, m.LDAP)
+	addConnectorKeys(h, keys, "ldap", m.LDAP)
 	addConnectorNames(h, names, m.Lokalise)
+	addConnectorKeys(h, keys, "lokalise", m.Lokalise)
 	addConnectorNames(h, names, m.Mixpanel)
+	addConnectorKeys(h, keys, "mixpanel", m.Mixpanel)
 	addConnectorNames(h, names, m.MParticle)
+	addConnectorKeys(h, keys, "mparticle", m.MParticle)
 	addConnectorNames(h, names, m.NewRelic)
+	addConnectorKeys(h, keys, "newrelic", m.NewRelic)
 	addConnectorNames(h, names, m.OpenTelemetry)
+	addConnectorKeys(h, keys, "opentelemetry", m.OpenTelemetry)
 	addConnectorNames(h, names, m.Pendo)
+	addConnectorKeys(h, keys, "pendo", m.Pendo)
 	addConnectorNames(h, names, m.PingDirectory)
+	addConnectorKeys(h, keys, "ping_directory", m.PingDirectory)
 	addConnectorNames(h, names, m.Postmark)
+	addConnectorKeys(h, keys, "postmark", m.Postmark)
 	addConnectorNames(h, names, m.Radar)
+	addConnectorKeys(h, keys, "radar", m.Radar)
 	addConnectorNames(h, names, m.Recaptcha)
+	addConnectorKeys(h, keys, "recaptcha", m.Recaptcha)
 	addConnectorNames(h, names, m.RecaptchaEnterprise)
+	addConnectorKeys(h, keys, "recaptcha_enterprise", m.RecaptchaEnterprise)
 	addConnectorNames(h, names, m.RecaptchaV2)
+	addConnectorKeys(h, keys, "recaptcha_v2", m.RecaptchaV2)
 	addConnectorNames(h, names, m.Rekognition)
+	addConnectorKeys(h, keys, "rekognition", m.Rekognition)
 	addConnectorNames(h, names, m.RNDReassigned)
+	addConnectorKeys(h, keys, "rnd_reassigned", m.RNDReassigned)
 	addConnectorNames(h, names, m.Salesforce)
+	addConnectorKeys(h, keys, "salesforce", m.Salesforce)
 	addConnectorNames(h, names, m.SalesforceMarketingCloud)
+	addConnectorKeys(h, keys, "salesforce_marketing_cloud", m.SalesforceMarketingCloud)
 	addConnectorNames(h, names, m.Sardine)
+	addConnectorKeys(h, keys, "sardine", m.Sardine)
 	addConnectorNames(h, names, m.SCIM)
+	addConnectorKeys(h, keys, "scim", m.SCIM)
 	addConnectorNames(h, names, m.Segment)
+	addConnectorKeys(h, keys, "segment", m.Segment)
 	addConnectorNames(h, names, m.SendGrid)
+	addConnectorKeys(h, keys, "sendgrid", m.SendGrid)
 	addConnectorNames(h, names, m.SES)
+	addConnectorKeys(h, keys, "ses", m.SES)
 	addConnectorNames(h, names, m.Slack)
+	addConnectorKeys(h, keys, "slack", m.Slack)
 	addConnectorNames(h, names, m.Smartling)
+	addConnectorKeys(h, keys, "smartling", m.Smartling)
 	addConnectorNames(h, names, m.SMTP)
+	addConnectorKeys(h, keys, "smtp", m.SMTP)
 	addConnectorNames(h, names, m.Snowflake)
+	addConnectorKeys(h, keys, "snowflake", m.Snowflake)
 	addConnectorNames(h, names, m.SNS)
+	addConnectorKeys(h, keys, "sns", m.SNS)
 	addConnectorNames(h, names, m.Splunk)
+	addConnectorKeys(h, keys, "splunk", m.Splunk)
 	addConnectorNames(h, names, m.SQL)
+	addConnectorKeys(h, keys, "sql", m.SQL)
 	addConnectorNames(h, names, m.SumoLogic)
+	addConnectorKeys(h, keys, "sumologic", m.SumoLogic)
 	addConnectorNames(h, names, m.Supabase)
+	addConnectorKeys(h, keys, "supabase", m.Supabase)
 	addConnectorNames(h, names, m.Telesign)
+	addConnectorKeys(h, keys, "telesign", m.Telesign)
 	addConnectorNames(h, names, m.Traceable)
+	addConnectorKeys(h, keys, "traceable", m.Traceable)
 	addConnectorNames(h, names, m.Turnstile)
+	addConnectorKeys(h, keys, "turnstile", m.Turnstile)
 	addConnectorNames(h, names, m.TwilioCore)
+	addConnectorKeys(h, keys, "twilio_core", m.TwilioCore)
 	addConnectorNames(h, names, m.TwilioVerify)
+	addConnectorKeys(h, keys, "twilio_verify", m.TwilioVerify)
 	addConnectorNames(h, names, m.Unibeam)
+	addConnectorKeys(h, keys, "unibeam", m.Unibeam)
 	addConnectorNames(h, names, m.ZeroBounce)
+	addConnectorKeys(h, keys, "zerobounce", m.ZeroBounce)
 	for k, v := range names {
 		if v > 1 {
 			h.Error("Connector names must be unique", "The connector name '%s' is used %d times", k, v)
 		}
 	}
+	for k, v := range keys {
+		if v > 1 {
+			h.Error("Connector keys must be unique", "The connector key '%s' is used %d times", k, v)
+		}
+	}
 }
 
 func (m *ConnectorsModel) Modify(h *helpers.Handler, state *ConnectorsModel) {
-	listattr.ModifyMatchingNames(h, &m.AbuseIPDB, state.AbuseIPDB)
-	listattr.ModifyMatchingNames(h, &m.Alloy, state.Alloy)
-	listattr.ModifyMatchingNames(h, &m.Amplitude, state.Amplitude)
-	listattr.ModifyMatchingNames(h, &m.Arkose, state.Arkose)
-	listattr.ModifyMatchingNames(h, &m.AuditWebhook, state.AuditWebhook)
-	listattr.ModifyMatchingNames(h, &m.AWSS3, state.AWSS3)
-	listattr.ModifyMatchingNames(h, &m.AWSSESEmailValidation, state.AWSSESEmailValidation)
-	listattr.ModifyMatchingNames(h, &m.AWSTranslate, state.AWSTranslate)
-	listattr.ModifyMatchingNames(h, &m.Bitsight, state.Bitsight)
-	listattr.ModifyMatchingNames(h, &m.Coralogix, state.Coralogix)
-	listattr.ModifyMatchingNames(h, &m.Cribl, state.Cribl)
-	listattr.ModifyMatchingNames(h, &m.Darwinium, state.Darwinium)
-	listattr.ModifyMatchingNames(h, &m.Datadog, state.Datadog)
-	listattr.ModifyMatchingNames(h, &m.DevRevGrow, state.DevRevGrow)
-	listattr.ModifyMatchingNames(h, &m.Docebo, state.Docebo)
-	listattr.ModifyMatchingNames(h, &m.EightByEightViber, state.EightByEightViber)
-	listattr.ModifyMatchingNames(h, &m.EightByEightWhatsapp, state.EightByEightWhatsapp)
-	listattr.ModifyMatchingNames(h, &m.Elephant, state.Elephant)
-	listattr.ModifyMatchingNames(h, &m.ExternalTokenHTTP, state.ExternalTokenHTTP)
-	listattr.ModifyMatchingNames(h, &m.Fingerprint, state.Fingerprint)
-	listattr.ModifyMatchingNames(h, &m.FingerprintDescope, state.FingerprintDescope)
-	listattr.ModifyMatchingNames(h, &m.FirebaseAdmin, state.FirebaseAdmin)
-	listattr.ModifyMatchingNames(h, &m.Forter, state.Forter)
-	listattr.ModifyMatchingNames(h, &m.GenericEmailGateway, state.GenericEmailGateway)
-	listattr.ModifyMatchingNames(h, &m.GenericSMSGateway, state.GenericSMSGateway)
-	listattr.ModifyMatchingNames(h, &m.GoogleCloudTranslation, state.GoogleCloudTranslation)
-	listattr.ModifyMatchingNames(h, &m.GoogleMapsPlaces, state.GoogleMapsPlaces)
-	listattr.ModifyMatchingNames(h, &m.GoogleCloudLogging, state.GoogleCloudLogging)
-	listattr.ModifyMatchingNames(h, &m.Groundcover, state.Groundcover)
-	listattr.ModifyMatchingNames(h, &m.HCaptcha, state.HCaptcha)
-	listattr.ModifyMatchingNames(h, &m.HIBP, state.HIBP)
-	listattr.ModifyMatchingNames(h, &m.HTTP, state.HTTP)
-	listattr.ModifyMatchingNames(h, &m.HubSpot, state.HubSpot)
-	listattr.ModifyMatchingNames(h, &m.Incode, state.Incode)
-	listattr.ModifyMatchingNames(h, &m.Intercom, state.Intercom)
-	listattr.ModifyMatchingNames(h, &m.LDAP, state.LDAP)
-	listattr.ModifyMatchingNames(h, &m.Lokalise, state.Lokalise)
-	listattr.ModifyMatchingNames(h, &m.Mixpanel, state.Mixpanel)
-	listattr.ModifyMatchingNames(h, &m.MParticle, state.MParticle)
-	listattr.ModifyMatchingNames(h, &m.NewRelic, state.NewRelic)
-	listattr.ModifyMatchingNames(h, &m.OpenTelemetry, state.OpenTelemetry)
-	listattr.ModifyMatchingNames(h, &m.Pendo, state.Pendo)
-	listattr.ModifyMatchingNames(h, &m.PingDirectory, state.PingDirectory)
-	listattr.ModifyMatchingNames(h, &m.Postmark, state.Postmark)
-	listattr.ModifyMatchingNames(h, &m.Radar, state.Radar)
-	listattr.ModifyMatchingNames(h, &m.Recaptcha, state.Recaptcha)
-	listattr.ModifyMatchingNames(h, &m.RecaptchaEnterprise, state.RecaptchaEnterprise)
-	listattr.ModifyMatchingNames(h, &m.RecaptchaV2, state.RecaptchaV2)
-	listattr.ModifyMatchingNames(h, &m.Rekognition, state.Rekognition)
-	listattr.ModifyMatchingNames(h, &m.RNDReassigned, state.RNDReassigned)
-	listattr.ModifyMatchingNames(h, &m.Salesforce, state.Salesforce)
-	listattr.ModifyMatchingNames(h, &m.SalesforceMarketingCloud, state.SalesforceMarketingCloud)
-	listattr.ModifyMatchingNames(h, &m.Sardine, state.Sardine)
-	listattr.ModifyMatchingNames(h, &m.SCIM, state.SCIM)
-	listattr.ModifyMatchingNames(h, &m.Segment, state.Segment)
-	listattr.ModifyMatchingNames(h, &m.SendGrid, state.SendGrid)
-	listattr.ModifyMatchingNames(h, &m.SES, state.SES)
-	listattr.ModifyMatchingNames(h, &m.Slack, state.Slack)
-	listattr.ModifyMatchingNames(h, &m.Smartling, state.Smartling)
-	listattr.ModifyMatchingNames(h, &m.SMTP, state.SMTP)
-	listattr.ModifyMatchingNames(h, &m.Snowflake, state.Snowflake)
-	listattr.ModifyMatchingNames(h, &m.SNS, state.SNS)
-	listattr.ModifyMatchingNames(h, &m.Splunk, state.Splunk)
-	listattr.ModifyMatchingNames(h, &m.SQL, state.SQL)
-	listattr.ModifyMatchingNames(h, &m.SumoLogic, state.SumoLogic)
-	listattr.ModifyMatchingNames(h, &m.Supabase, state.Supabase)
-	listattr.ModifyMatchingNames(h, &m.Telesign, state.Telesign)
-	listattr.ModifyMatchingNames(h, &m.Traceable, state.Traceable)
-	listattr.ModifyMatchingNames(h, &m.Turnstile, state.Turnstile)
-	listattr.ModifyMatchingNames(h, &m.TwilioCore, state.TwilioCore)
-	listattr.ModifyMatchingNames(h, &m.TwilioVerify, state.TwilioVerify)
-	listattr.ModifyMatchingNames(h, &m.Unibeam, state.Unibeam)
-	listattr.ModifyMatchingNames(h, &m.ZeroBounce, state.ZeroBounce)
+	listattr.ModifyMatchingKeysOrNames(h, &m.AbuseIPDB, state.AbuseIPDB)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Alloy, state.Alloy)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Amplitude, state.Amplitude)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Arkose, state.Arkose)
+	listattr.ModifyMatchingKeysOrNames(h, &m.AuditWebhook, state.AuditWebhook)
+	listattr.ModifyMatchingKeysOrNames(h, &m.AWSS3, state.AWSS3)
+	listattr.ModifyMatchingKeysOrNames(h, &m.AWSSESEmailValidation, state.AWSSESEmailValidation)
+	listattr.ModifyMatchingKeysOrNames(h, &m.AWSTranslate, state.AWSTranslate)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Bitsight, state.Bitsight)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Coralogix, state.Coralogix)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Cribl, state.Cribl)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Darwinium, state.Darwinium)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Datadog, state.Datadog)
+	listattr.ModifyMatchingKeysOrNames(h, &m.DevRevGrow, state.DevRevGrow)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Docebo, state.Docebo)
+	listattr.ModifyMatchingKeysOrNames(h, &m.EightByEightViber, state.EightByEightViber)
+	listattr.ModifyMatchingKeysOrNames(h, &m.EightByEightWhatsapp, state.EightByEightWhatsapp)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Elephant, state.Elephant)
+	listattr.ModifyMatchingKeysOrNames(h, &m.ExternalTokenHTTP, state.ExternalTokenHTTP)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Fingerprint, state.Fingerprint)
+	listattr.ModifyMatchingKeysOrNames(h, &m.FingerprintDescope, state.FingerprintDescope)
+	listattr.ModifyMatchingKeysOrNames(h, &m.FirebaseAdmin, state.FirebaseAdmin)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Forter, state.Forter)
+	listattr.ModifyMatchingKeysOrNames(h, &m.GenericEmailGateway, state.GenericEmailGateway)
+	listattr.ModifyMatchingKeysOrNames(h, &m.GenericSMSGateway, state.GenericSMSGateway)
+	listattr.ModifyMatchingKeysOrNames(h, &m.GoogleCloudTranslation, state.GoogleCloudTranslation)
+	listattr.ModifyMatchingKeysOrNames(h, &m.GoogleMapsPlaces, state.GoogleMapsPlaces)
+	listattr.ModifyMatchingKeysOrNames(h, &m.GoogleCloudLogging, state.GoogleCloudLogging)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Groundcover, state.Groundcover)
+	listattr.ModifyMatchingKeysOrNames(h, &m.HCaptcha, state.HCaptcha)
+	listattr.ModifyMatchingKeysOrNames(h, &m.HIBP, state.HIBP)
+	listattr.ModifyMatchingKeysOrNames(h, &m.HTTP, state.HTTP)
+	listattr.ModifyMatchingKeysOrNames(h, &m.HubSpot, state.HubSpot)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Incode, state.Incode)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Intercom, state.Intercom)
+	listattr.ModifyMatchingKeysOrNames(h, &m.LDAP, state.LDAP)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Lokalise, state.Lokalise)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Mixpanel, state.Mixpanel)
+	listattr.ModifyMatchingKeysOrNames(h, &m.MParticle, state.MParticle)
+	listattr.ModifyMatchingKeysOrNames(h, &m.NewRelic, state.NewRelic)
+	listattr.ModifyMatchingKeysOrNames(h, &m.OpenTelemetry, state.OpenTelemetry)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Pendo, state.Pendo)
+	listattr.ModifyMatchingKeysOrNames(h, &m.PingDirectory, state.PingDirectory)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Postmark, state.Postmark)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Radar, state.Radar)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Recaptcha, state.Recaptcha)
+	listattr.ModifyMatchingKeysOrNames(h, &m.RecaptchaEnterprise, state.RecaptchaEnterprise)
+	listattr.ModifyMatchingKeysOrNames(h, &m.RecaptchaV2, state.RecaptchaV2)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Rekognition, state.Rekognition)
+	listattr.ModifyMatchingKeysOrNames(h, &m.RNDReassigned, state.RNDReassigned)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Salesforce, state.Salesforce)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SalesforceMarketingCloud, state.SalesforceMarketingCloud)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Sardine, state.Sardine)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SCIM, state.SCIM)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Segment, state.Segment)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SendGrid, state.SendGrid)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SES, state.SES)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Slack, state.Slack)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Smartling, state.Smartling)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SMTP, state.SMTP)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Snowflake, state.Snowflake)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SNS, state.SNS)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Splunk, state.Splunk)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SQL, state.SQL)
+	listattr.ModifyMatchingKeysOrNames(h, &m.SumoLogic, state.SumoLogic)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Supabase, state.Supabase)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Telesign, state.Telesign)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Traceable, state.Traceable)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Turnstile, state.Turnstile)
+	listattr.ModifyMatchingKeysOrNames(h, &m.TwilioCore, state.TwilioCore)
+	listattr.ModifyMatchingKeysOrNames(h, &m.TwilioVerify, state.TwilioVerify)
+	listattr.ModifyMatchingKeysOrNames(h, &m.Unibeam, state.Unibeam)
+	listattr.ModifyMatchingKeysOrNames(h, &m.ZeroBounce, state.ZeroBounce)
 
 	// Upgrade existing identifiers for SMTP connectors to support static IPs
 	for c := range listattr.MutatingIterator(&m.SMTP, h) {
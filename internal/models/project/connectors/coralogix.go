@@ -15,6 +15,7 @@ var CoralogixValidator = objattr.NewValidator[CoralogixModel]("must have a valid
 
 var CoralogixAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var CoralogixAttributes = map[string]schema.Attribute{
 
 type CoralogixModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -85,6 +87,10 @@ func (m *CoralogixModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *CoralogixModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *CoralogixModel) GetName() stringattr.Type {
 	return m.Name
 }
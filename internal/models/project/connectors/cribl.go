@@ -15,6 +15,7 @@ var CriblValidator = objattr.NewValidator[CriblModel]("must have a valid configu
 
 var CriblAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -31,6 +32,7 @@ var CriblAttributes = map[string]schema.Attribute{
 
 type CriblModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -89,6 +91,10 @@ func (m *CriblModel) SetConfigurationValues(c map[string]any, h *helpers.Handler
 
 // Matching
 
+func (m *CriblModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *CriblModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -11,6 +11,7 @@ import (
 
 var DarwiniumAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var DarwiniumAttributes = map[string]schema.Attribute{
 
 type DarwiniumModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -91,6 +93,10 @@ func (m *DarwiniumModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *DarwiniumModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *DarwiniumModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var DatadogValidator = objattr.NewValidator[DatadogModel]("must have a valid con
 
 var DatadogAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -32,6 +33,7 @@ var DatadogAttributes = map[string]schema.Attribute{
 
 type DatadogModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -93,6 +95,10 @@ func (m *DatadogModel) SetConfigurationValues(c map[string]any, h *helpers.Handl
 
 // Matching
 
+func (m *DatadogModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *DatadogModel) GetName() stringattr.Type {
 	return m.Name
 }
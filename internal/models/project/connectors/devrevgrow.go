@@ -10,6 +10,7 @@ import (
 
 var DevRevGrowAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -20,6 +21,7 @@ var DevRevGrowAttributes = map[string]schema.Attribute{
 
 type DevRevGrowModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -54,6 +56,10 @@ func (m *DevRevGrowModel) SetConfigurationValues(c map[string]any, h *helpers.Ha
 
 // Matching
 
+func (m *DevRevGrowModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *DevRevGrowModel) GetName() stringattr.Type {
 	return m.Name
 }
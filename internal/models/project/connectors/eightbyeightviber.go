@@ -11,6 +11,7 @@ import (
 
 var EightByEightViberAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -24,6 +25,7 @@ var EightByEightViberAttributes = map[string]schema.Attribute{
 
 type EightByEightViberModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -67,6 +69,10 @@ func (m *EightByEightViberModel) SetConfigurationValues(c map[string]any, h *hel
 
 // Matching
 
+func (m *EightByEightViberModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *EightByEightViberModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -11,6 +11,7 @@ import (
 
 var EightByEightWhatsappAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -25,6 +26,7 @@ var EightByEightWhatsappAttributes = map[string]schema.Attribute{
 
 type EightByEightWhatsappModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -71,6 +73,10 @@ func (m *EightByEightWhatsappModel) SetConfigurationValues(c map[string]any, h *
 
 // Matching
 
+func (m *EightByEightWhatsappModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *EightByEightWhatsappModel) GetName() stringattr.Type {
 	return m.Name
 }
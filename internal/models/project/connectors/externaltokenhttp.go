@@ -13,30 +13,34 @@ import (
 
 var ExternalTokenHTTPAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"endpoint":       stringattr.Required(),
-	"authentication": objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":        strmapattr.Default(),
-	"hmac_secret":    stringattr.SecretOptional(),
-	"insecure":       boolattr.Default(false),
-	"use_static_ips": boolattr.Default(false),
+	"endpoint":          stringattr.Required(),
+	"authentication":    objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
+	"headers":           strmapattr.Default(HeadersValidator),
+	"sensitive_headers": strmapattr.SecretDefault(SensitiveHeadersValidator),
+	"hmac_secret":       stringattr.SecretOptional(),
+	"insecure":          boolattr.Default(false),
+	"use_static_ips":    boolattr.Default(false),
 }
 
 // Model
 
 type ExternalTokenHTTPModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
-	Endpoint       stringattr.Type                  `tfsdk:"endpoint"`
-	Authentication objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
-	Headers        strmapattr.Type                  `tfsdk:"headers"`
-	HMACSecret     stringattr.Type                  `tfsdk:"hmac_secret"`
-	Insecure       boolattr.Type                    `tfsdk:"insecure"`
-	UseStaticIPs   boolattr.Type                    `tfsdk:"use_static_ips"`
+	Endpoint         stringattr.Type                  `tfsdk:"endpoint"`
+	Authentication   objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
+	Headers          strmapattr.Type                  `tfsdk:"headers"`
+	SensitiveHeaders strmapattr.Type                  `tfsdk:"sensitive_headers"`
+	HMACSecret       stringattr.Type                  `tfsdk:"hmac_secret"`
+	Insecure         boolattr.Type                    `tfsdk:"insecure"`
+	UseStaticIPs     boolattr.Type                    `tfsdk:"use_static_ips"`
 }
 
 func (m *ExternalTokenHTTPModel) Values(h *helpers.Handler) map[string]any {
@@ -60,6 +64,7 @@ func (m *ExternalTokenHTTPModel) ConfigurationValues(h *helpers.Handler) map[str
 	stringattr.Get(m.Endpoint, c, "endpoint")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	stringattr.Get(m.HMACSecret, c, "hmacSecret")
 	boolattr.Get(m.Insecure, c, "insecure")
 	boolattr.Get(m.UseStaticIPs, c, "useStaticIps")
@@ -70,6 +75,7 @@ func (m *ExternalTokenHTTPModel) SetConfigurationValues(c map[string]any, h *hel
 	stringattr.Set(&m.Endpoint, c, "endpoint")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	stringattr.Nil(&m.HMACSecret)
 	boolattr.Set(&m.Insecure, c, "insecure")
 	boolattr.Set(&m.UseStaticIPs, c, "useStaticIps")
@@ -77,6 +83,10 @@ func (m *ExternalTokenHTTPModel) SetConfigurationValues(c map[string]any, h *hel
 
 // Matching
 
+func (m *ExternalTokenHTTPModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *ExternalTokenHTTPModel) GetName() stringattr.Type {
 	return m.Name
 }
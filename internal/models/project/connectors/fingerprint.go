@@ -14,6 +14,7 @@ var FingerprintValidator = objattr.NewValidator[FingerprintModel]("must have a v
 
 var FingerprintAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -28,6 +29,7 @@ var FingerprintAttributes = map[string]schema.Attribute{
 
 type FingerprintModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -83,6 +85,10 @@ func (m *FingerprintModel) SetConfigurationValues(c map[string]any, h *helpers.H
 
 // Matching
 
+func (m *FingerprintModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *FingerprintModel) GetName() stringattr.Type {
 	return m.Name
 }
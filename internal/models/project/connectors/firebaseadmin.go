@@ -5,21 +5,24 @@ package connectors
 import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 var FirebaseAdminAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"service_account": stringattr.SecretRequired(),
+	"service_account": stringattr.SecretRequired(keymaterial.ServiceAccountValidator),
 }
 
 // Model
 
 type FirebaseAdminModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -54,6 +57,10 @@ func (m *FirebaseAdminModel) SetConfigurationValues(c map[string]any, h *helpers
 
 // Matching
 
+func (m *FirebaseAdminModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *FirebaseAdminModel) GetName() stringattr.Type {
 	return m.Name
 }
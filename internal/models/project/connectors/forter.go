@@ -14,6 +14,7 @@ var ForterValidator = objattr.NewValidator[ForterModel]("must have a valid confi
 
 var ForterAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -29,6 +30,7 @@ var ForterAttributes = map[string]schema.Attribute{
 
 type ForterModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -87,6 +89,10 @@ func (m *ForterModel) SetConfigurationValues(c map[string]any, h *helpers.Handle
 
 // Matching
 
+func (m *ForterModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *ForterModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -13,32 +13,36 @@ import (
 
 var GenericSMSGatewayAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"post_url":       stringattr.Required(),
-	"sender":         stringattr.Default(""),
-	"authentication": objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":        strmapattr.Default(),
-	"hmac_secret":    stringattr.SecretOptional(),
-	"insecure":       boolattr.Default(false),
-	"use_static_ips": boolattr.Default(false),
+	"post_url":          stringattr.Required(),
+	"sender":            stringattr.Default(""),
+	"authentication":    objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
+	"headers":           strmapattr.Default(HeadersValidator),
+	"sensitive_headers": strmapattr.SecretDefault(SensitiveHeadersValidator),
+	"hmac_secret":       stringattr.SecretOptional(),
+	"insecure":          boolattr.Default(false),
+	"use_static_ips":    boolattr.Default(false),
 }
 
 // Model
 
 type GenericSMSGatewayModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
-	PostURL        stringattr.Type                  `tfsdk:"post_url"`
-	Sender         stringattr.Type                  `tfsdk:"sender"`
-	Authentication objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
-	Headers        strmapattr.Type                  `tfsdk:"headers"`
-	HMACSecret     stringattr.Type                  `tfsdk:"hmac_secret"`
-	Insecure       boolattr.Type                    `tfsdk:"insecure"`
-	UseStaticIPs   boolattr.Type                    `tfsdk:"use_static_ips"`
+	PostURL          stringattr.Type                  `tfsdk:"post_url"`
+	Sender           stringattr.Type                  `tfsdk:"sender"`
+	Authentication   objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
+	Headers          strmapattr.Type                  `tfsdk:"headers"`
+	SensitiveHeaders strmapattr.Type                  `tfsdk:"sensitive_headers"`
+	HMACSecret       stringattr.Type                  `tfsdk:"hmac_secret"`
+	Insecure         boolattr.Type                    `tfsdk:"insecure"`
+	UseStaticIPs     boolattr.Type                    `tfsdk:"use_static_ips"`
 }
 
 func (m *GenericSMSGatewayModel) Values(h *helpers.Handler) map[string]any {
@@ -63,6 +67,7 @@ func (m *GenericSMSGatewayModel) ConfigurationValues(h *helpers.Handler) map[str
 	stringattr.Get(m.Sender, c, "sender")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	stringattr.Get(m.HMACSecret, c, "hmacSecret")
 	boolattr.Get(m.Insecure, c, "insecure")
 	boolattr.Get(m.UseStaticIPs, c, "useStaticIps")
@@ -74,6 +79,7 @@ func (m *GenericSMSGatewayModel) SetConfigurationValues(c map[string]any, h *hel
 	stringattr.Set(&m.Sender, c, "sender")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	stringattr.Nil(&m.HMACSecret)
 	boolattr.Set(&m.Insecure, c, "insecure")
 	boolattr.Set(&m.UseStaticIPs, c, "useStaticIps")
@@ -81,6 +87,10 @@ func (m *GenericSMSGatewayModel) SetConfigurationValues(c map[string]any, h *hel
 
 // Matching
 
+func (m *GenericSMSGatewayModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *GenericSMSGatewayModel) GetName() stringattr.Type {
 	return m.Name
 }
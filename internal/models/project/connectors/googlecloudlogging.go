@@ -8,6 +8,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -15,10 +16,11 @@ var GoogleCloudLoggingValidator = objattr.NewValidator[GoogleCloudLoggingModel](
 
 var GoogleCloudLoggingAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"service_account_key":      stringattr.SecretRequired(),
+	"service_account_key":      stringattr.SecretRequired(keymaterial.ServiceAccountValidator),
 	"audit_enabled":            boolattr.Default(true),
 	"audit_filters":            listattr.Default[AuditFilterFieldModel](AuditFilterFieldAttributes),
 	"troubleshoot_log_enabled": boolattr.Default(false),
@@ -28,6 +30,7 @@ var GoogleCloudLoggingAttributes = map[string]schema.Attribute{
 
 type GoogleCloudLoggingModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -77,6 +80,10 @@ func (m *GoogleCloudLoggingModel) SetConfigurationValues(c map[string]any, h *he
 
 // Matching
 
+func (m *GoogleCloudLoggingModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *GoogleCloudLoggingModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -5,22 +5,25 @@ package connectors
 import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 var GoogleCloudTranslationAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
 	"project_id":           stringattr.Required(),
-	"service_account_json": stringattr.SecretRequired(),
+	"service_account_json": stringattr.SecretRequired(keymaterial.ServiceAccountValidator),
 }
 
 // Model
 
 type GoogleCloudTranslationModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -58,6 +61,10 @@ func (m *GoogleCloudTranslationModel) SetConfigurationValues(c map[string]any, h
 
 // Matching
 
+func (m *GoogleCloudTranslationModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *GoogleCloudTranslationModel) GetName() stringattr.Type {
 	return m.Name
 }
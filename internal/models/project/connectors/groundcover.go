@@ -15,6 +15,7 @@ var GroundcoverValidator = objattr.NewValidator[GroundcoverModel]("must have a v
 
 var GroundcoverAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var GroundcoverAttributes = map[string]schema.Attribute{
 
 type GroundcoverModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -85,6 +87,10 @@ func (m *GroundcoverModel) SetConfigurationValues(c map[string]any, h *helpers.H
 
 // Matching
 
+func (m *GroundcoverModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *GroundcoverModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var HCaptchaValidator = objattr.NewValidator[HCaptchaModel]("must have a valid c
 
 var HCaptchaAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -29,6 +30,7 @@ var HCaptchaAttributes = map[string]schema.Attribute{
 
 type HCaptchaModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -81,6 +83,10 @@ func (m *HCaptchaModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *HCaptchaModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *HCaptchaModel) GetName() stringattr.Type {
 	return m.Name
 }
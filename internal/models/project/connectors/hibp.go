@@ -10,6 +10,7 @@ import (
 
 var HIBPAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 }
@@ -18,6 +19,7 @@ var HIBPAttributes = map[string]schema.Attribute{
 
 type HIBPModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 }
@@ -41,6 +43,10 @@ func (m *HIBPModel) ConfigurationValues(h *helpers.Handler) map[string]any {
 
 // Matching
 
+func (m *HIBPModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *HIBPModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/strmapattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/keymaterial"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -19,12 +20,14 @@ var HTTPValidator = objattr.NewValidator[HTTPModel]("must have a valid configura
 
 var HTTPAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
 	"base_url":                   stringattr.Required(),
 	"authentication":             objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":                    strmapattr.Default(),
+	"headers":                    strmapattr.Default(HeadersValidator),
+	"sensitive_headers":          strmapattr.SecretDefault(SensitiveHeadersValidator),
 	"hmac_secret":                stringattr.SecretOptional(),
 	"aws_auth_type":              stringattr.Default("none", stringvalidator.OneOf("", "none", "credentials", "assumeRole")),
 	"aws_access_key_id":          stringattr.SecretOptional(),
@@ -34,7 +37,7 @@ var HTTPAttributes = map[string]schema.Attribute{
 	"aws_region":                 stringattr.Default(""),
 	"aws_service":                stringattr.Default(""),
 	"rfc9421_signing_enabled":    boolattr.Default(false),
-	"rfc9421_private_key":        stringattr.SecretOptional(),
+	"rfc9421_private_key":        stringattr.SecretOptional(keymaterial.PrivateKeyValidator),
 	"rfc9421_key_id":             stringattr.Default(""),
 	"rfc9421_components":         stringattr.Default(""),
 	"rfc9421_signature_ttl":      floatattr.Default(300),
@@ -48,12 +51,14 @@ var HTTPAttributes = map[string]schema.Attribute{
 
 type HTTPModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
 	BaseURL                 stringattr.Type                  `tfsdk:"base_url"`
 	Authentication          objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
 	Headers                 strmapattr.Type                  `tfsdk:"headers"`
+	SensitiveHeaders        strmapattr.Type                  `tfsdk:"sensitive_headers"`
 	HMACSecret              stringattr.Type                  `tfsdk:"hmac_secret"`
 	AWSAuthType             stringattr.Type                  `tfsdk:"aws_auth_type"`
 	AWSAccessKeyID          stringattr.Type                  `tfsdk:"aws_access_key_id"`
@@ -147,6 +152,7 @@ func (m *HTTPModel) ConfigurationValues(h *helpers.Handler) map[string]any {
 	stringattr.Get(m.BaseURL, c, "baseUrl")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	stringattr.Get(m.HMACSecret, c, "hmacSecret")
 	stringattr.Get(m.AWSAuthType, c, "awsAuthType")
 	stringattr.Get(m.AWSAccessKeyID, c, "awsAccessKeyId")
@@ -170,6 +176,7 @@ func (m *HTTPModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 	stringattr.Set(&m.BaseURL, c, "baseUrl")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	stringattr.Nil(&m.HMACSecret)
 	stringattr.Set(&m.AWSAuthType, c, "awsAuthType")
 	stringattr.Nil(&m.AWSAccessKeyID)
@@ -190,6 +197,10 @@ func (m *HTTPModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *HTTPModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *HTTPModel) GetName() stringattr.Type {
 	return m.Name
 }
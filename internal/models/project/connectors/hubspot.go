@@ -11,6 +11,7 @@ import (
 
 var HubSpotAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -23,6 +24,7 @@ var HubSpotAttributes = map[string]schema.Attribute{
 
 type HubSpotModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -63,6 +65,10 @@ func (m *HubSpotModel) SetConfigurationValues(c map[string]any, h *helpers.Handl
 
 // Matching
 
+func (m *HubSpotModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *HubSpotModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var IntercomAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -21,6 +22,7 @@ var IntercomAttributes = map[string]schema.Attribute{
 
 type IntercomModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -58,6 +60,10 @@ func (m *IntercomModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *IntercomModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *IntercomModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -14,6 +14,7 @@ var LDAPValidator = objattr.NewValidator[LDAPModel]("must have a valid configura
 
 var LDAPAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -31,6 +32,7 @@ var LDAPAttributes = map[string]schema.Attribute{
 
 type LDAPModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -116,6 +118,10 @@ func (m *LDAPModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *LDAPModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *LDAPModel) GetName() stringattr.Type {
 	return m.Name
 }
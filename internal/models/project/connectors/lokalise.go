@@ -10,6 +10,7 @@ import (
 
 var LokaliseAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -24,6 +25,7 @@ var LokaliseAttributes = map[string]schema.Attribute{
 
 type LokaliseModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -70,6 +72,10 @@ func (m *LokaliseModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *LokaliseModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *LokaliseModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var MixpanelValidator = objattr.NewValidator[MixpanelModel]("must have a valid c
 
 var MixpanelAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -35,6 +36,7 @@ var MixpanelAttributes = map[string]schema.Attribute{
 
 type MixpanelModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -108,6 +110,10 @@ func (m *MixpanelModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *MixpanelModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *MixpanelModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -11,6 +11,7 @@ import (
 
 var MParticleAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -25,6 +26,7 @@ var MParticleAttributes = map[string]schema.Attribute{
 
 type MParticleModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -71,6 +73,10 @@ func (m *MParticleModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *MParticleModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *MParticleModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var NewRelicValidator = objattr.NewValidator[NewRelicModel]("must have a valid c
 
 var NewRelicAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -31,6 +32,7 @@ var NewRelicAttributes = map[string]schema.Attribute{
 
 type NewRelicModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -92,6 +94,10 @@ func (m *NewRelicModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *NewRelicModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *NewRelicModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -17,13 +17,15 @@ var OpenTelemetryValidator = objattr.NewValidator[OpenTelemetryModel]("must have
 
 var OpenTelemetryAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
 	"endpoint":                 stringattr.Required(),
 	"protocol":                 stringattr.Default("http", stringvalidator.OneOf("http", "grpc")),
 	"authentication":           objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":                  strmapattr.Default(),
+	"headers":                  strmapattr.Default(HeadersValidator),
+	"sensitive_headers":        strmapattr.SecretDefault(SensitiveHeadersValidator),
 	"insecure":                 boolattr.Default(false),
 	"audit_enabled":            boolattr.Default(true),
 	"audit_filters":            listattr.Default[AuditFilterFieldModel](AuditFilterFieldAttributes),
@@ -34,6 +36,7 @@ var OpenTelemetryAttributes = map[string]schema.Attribute{
 
 type OpenTelemetryModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -41,6 +44,7 @@ type OpenTelemetryModel struct {
 	Protocol               stringattr.Type                      `tfsdk:"protocol"`
 	Authentication         objattr.Type[HTTPAuthFieldModel]     `tfsdk:"authentication"`
 	Headers                strmapattr.Type                      `tfsdk:"headers"`
+	SensitiveHeaders       strmapattr.Type                      `tfsdk:"sensitive_headers"`
 	Insecure               boolattr.Type                        `tfsdk:"insecure"`
 	AuditEnabled           boolattr.Type                        `tfsdk:"audit_enabled"`
 	AuditFilters           listattr.Type[AuditFilterFieldModel] `tfsdk:"audit_filters"`
@@ -75,6 +79,7 @@ func (m *OpenTelemetryModel) ConfigurationValues(h *helpers.Handler) map[string]
 	stringattr.Get(m.Protocol, c, "protocol")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	boolattr.Get(m.Insecure, c, "insecure")
 	boolattr.Get(m.AuditEnabled, c, "auditEnabled")
 	listattr.Get(m.AuditFilters, c, "auditFilters", h)
@@ -87,6 +92,7 @@ func (m *OpenTelemetryModel) SetConfigurationValues(c map[string]any, h *helpers
 	stringattr.Set(&m.Protocol, c, "protocol")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	boolattr.Set(&m.Insecure, c, "insecure")
 	boolattr.Set(&m.AuditEnabled, c, "auditEnabled")
 	listattr.Set(&m.AuditFilters, c, "auditFilters", h)
@@ -95,6 +101,10 @@ func (m *OpenTelemetryModel) SetConfigurationValues(c map[string]any, h *helpers
 
 // Matching
 
+func (m *OpenTelemetryModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *OpenTelemetryModel) GetName() stringattr.Type {
 	return m.Name
 }
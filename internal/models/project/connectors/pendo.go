@@ -15,6 +15,7 @@ var PendoValidator = objattr.NewValidator[PendoModel]("must have a valid configu
 
 var PendoAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var PendoAttributes = map[string]schema.Attribute{
 
 type PendoModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -85,6 +87,10 @@ func (m *PendoModel) SetConfigurationValues(c map[string]any, h *helpers.Handler
 
 // Matching
 
+func (m *PendoModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *PendoModel) GetName() stringattr.Type {
 	return m.Name
 }
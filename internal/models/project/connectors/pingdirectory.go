@@ -11,6 +11,7 @@ import (
 
 var PingDirectoryAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var PingDirectoryAttributes = map[string]schema.Attribute{
 
 type PingDirectoryModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -59,6 +61,10 @@ func (m *PingDirectoryModel) SetConfigurationValues(c map[string]any, h *helpers
 
 // Matching
 
+func (m *PingDirectoryModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *PingDirectoryModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var PostmarkAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var PostmarkAttributes = map[string]schema.Attribute{
 
 type PostmarkModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -62,6 +64,10 @@ func (m *PostmarkModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *PostmarkModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *PostmarkModel) GetName() stringattr.Type {
 	return m.Name
 }
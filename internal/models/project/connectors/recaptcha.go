@@ -15,6 +15,7 @@ var RecaptchaValidator = objattr.NewValidator[RecaptchaModel]("must have a valid
 
 var RecaptchaAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -28,6 +29,7 @@ var RecaptchaAttributes = map[string]schema.Attribute{
 
 type RecaptchaModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -77,6 +79,10 @@ func (m *RecaptchaModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *RecaptchaModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *RecaptchaModel) GetName() stringattr.Type {
 	return m.Name
 }
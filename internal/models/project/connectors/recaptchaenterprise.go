@@ -16,6 +16,7 @@ var RecaptchaEnterpriseValidator = objattr.NewValidator[RecaptchaEnterpriseModel
 
 var RecaptchaEnterpriseAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -33,6 +34,7 @@ var RecaptchaEnterpriseAttributes = map[string]schema.Attribute{
 
 type RecaptchaEnterpriseModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -95,6 +97,10 @@ func (m *RecaptchaEnterpriseModel) SetConfigurationValues(c map[string]any, h *h
 
 // Matching
 
+func (m *RecaptchaEnterpriseModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *RecaptchaEnterpriseModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var RecaptchaV2Validator = objattr.NewValidator[RecaptchaV2Model]("must have a v
 
 var RecaptchaV2Attributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -29,6 +30,7 @@ var RecaptchaV2Attributes = map[string]schema.Attribute{
 
 type RecaptchaV2Model struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -81,6 +83,10 @@ func (m *RecaptchaV2Model) SetConfigurationValues(c map[string]any, h *helpers.H
 
 // Matching
 
+func (m *RecaptchaV2Model) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *RecaptchaV2Model) GetName() stringattr.Type {
 	return m.Name
 }
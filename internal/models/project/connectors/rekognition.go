@@ -10,6 +10,7 @@ import (
 
 var RekognitionAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var RekognitionAttributes = map[string]schema.Attribute{
 
 type RekognitionModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -62,6 +64,10 @@ func (m *RekognitionModel) SetConfigurationValues(c map[string]any, h *helpers.H
 
 // Matching
 
+func (m *RekognitionModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *RekognitionModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var SalesforceAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -23,6 +24,7 @@ var SalesforceAttributes = map[string]schema.Attribute{
 
 type SalesforceModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -66,6 +68,10 @@ func (m *SalesforceModel) SetConfigurationValues(c map[string]any, h *helpers.Ha
 
 // Matching
 
+func (m *SalesforceModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SalesforceModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -11,32 +11,36 @@ import (
 
 var SCIMAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
-	"disabled":         boolattr.Default(false),
-	"federated_app_id": stringattr.Required(),
-	"base_url":         stringattr.Required(),
-	"authentication":   objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
-	"headers":          strmapattr.Default(),
-	"hmac_secret":      stringattr.SecretOptional(),
-	"insecure":         boolattr.Default(false),
+	"disabled":          boolattr.Default(false),
+	"federated_app_id":  stringattr.Required(),
+	"base_url":          stringattr.Required(),
+	"authentication":    objattr.Default(HTTPAuthFieldDefault, HTTPAuthFieldAttributes, HTTPAuthFieldValidator),
+	"headers":           strmapattr.Default(HeadersValidator),
+	"sensitive_headers": strmapattr.SecretDefault(SensitiveHeadersValidator),
+	"hmac_secret":       stringattr.SecretOptional(),
+	"insecure":          boolattr.Default(false),
 }
 
 // Model
 
 type SCIMModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
-	Disabled       boolattr.Type                    `tfsdk:"disabled"`
-	FederatedAppID stringattr.Type                  `tfsdk:"federated_app_id"`
-	BaseURL        stringattr.Type                  `tfsdk:"base_url"`
-	Authentication objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
-	Headers        strmapattr.Type                  `tfsdk:"headers"`
-	HMACSecret     stringattr.Type                  `tfsdk:"hmac_secret"`
-	Insecure       boolattr.Type                    `tfsdk:"insecure"`
+	Disabled         boolattr.Type                    `tfsdk:"disabled"`
+	FederatedAppID   stringattr.Type                  `tfsdk:"federated_app_id"`
+	BaseURL          stringattr.Type                  `tfsdk:"base_url"`
+	Authentication   objattr.Type[HTTPAuthFieldModel] `tfsdk:"authentication"`
+	Headers          strmapattr.Type                  `tfsdk:"headers"`
+	SensitiveHeaders strmapattr.Type                  `tfsdk:"sensitive_headers"`
+	HMACSecret       stringattr.Type                  `tfsdk:"hmac_secret"`
+	Insecure         boolattr.Type                    `tfsdk:"insecure"`
 }
 
 func (m *SCIMModel) Values(h *helpers.Handler) map[string]any {
@@ -71,6 +75,7 @@ func (m *SCIMModel) ConfigurationValues(h *helpers.Handler) map[string]any {
 	stringattr.Get(m.BaseURL, c, "baseUrl")
 	objattr.Get(m.Authentication, c, "authentication", h)
 	getHeaders(m.Headers, c, "headers", h)
+	getHeaders(m.SensitiveHeaders, c, "headers", h)
 	stringattr.Get(m.HMACSecret, c, "hmacSecret")
 	boolattr.Get(m.Insecure, c, "insecure")
 	return c
@@ -81,12 +86,17 @@ func (m *SCIMModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 	stringattr.Set(&m.BaseURL, c, "baseUrl")
 	objattr.Set(&m.Authentication, c, "authentication", h)
 	setHeaders(&m.Headers, c, "headers", h)
+	setSensitiveHeaders(&m.SensitiveHeaders, &m.Headers, h)
 	stringattr.Nil(&m.HMACSecret)
 	boolattr.Set(&m.Insecure, c, "insecure")
 }
 
 // Matching
 
+func (m *SCIMModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SCIMModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var SegmentAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -21,6 +22,7 @@ var SegmentAttributes = map[string]schema.Attribute{
 
 type SegmentModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -58,6 +60,10 @@ func (m *SegmentModel) SetConfigurationValues(c map[string]any, h *helpers.Handl
 
 // Matching
 
+func (m *SegmentModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SegmentModel) GetName() stringattr.Type {
 	return m.Name
 }
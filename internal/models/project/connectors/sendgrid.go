@@ -9,6 +9,7 @@ import (
 
 var SendGridAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -20,6 +21,7 @@ var SendGridAttributes = map[string]schema.Attribute{
 
 type SendGridModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -57,6 +59,10 @@ func (m *SendGridModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *SendGridModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SendGridModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -12,6 +12,7 @@ var SESValidator = objattr.NewValidator[SESModel]("must have a valid configurati
 
 var SESAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -29,6 +30,7 @@ var SESAttributes = map[string]schema.Attribute{
 
 type SESModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -118,6 +120,10 @@ func (m *SESModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *SESModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SESModel) GetName() stringattr.Type {
 	return m.Name
 }
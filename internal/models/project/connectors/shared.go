@@ -1,6 +1,12 @@
 package connectors
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
@@ -10,7 +16,9 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // Common values
@@ -69,6 +77,32 @@ func getConnectorEngine(data map[string]any, engineID *stringattr.Type) {
 	}
 }
 
+// Insecure connectors
+
+// Returns the names of all connectors that are configured to skip verification of the TLS
+// certificates of the servers they connect to.
+func (m *ConnectorsModel) InsecureConnectorNames(h *helpers.Handler) []string {
+	names := []string{}
+	names = appendInsecureConnectors(names, m.AuditWebhook, func(c *AuditWebhookModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.ExternalTokenHTTP, func(c *ExternalTokenHTTPModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.GenericEmailGateway, func(c *GenericEmailGatewayModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.GenericSMSGateway, func(c *GenericSMSGatewayModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.HTTP, func(c *HTTPModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.OpenTelemetry, func(c *OpenTelemetryModel) boolattr.Type { return c.Insecure }, h)
+	names = appendInsecureConnectors(names, m.SCIM, func(c *SCIMModel) boolattr.Type { return c.Insecure }, h)
+	return names
+}
+
+func appendInsecureConnectors[T any, M helpers.NamedModel[T]](names []string, connectors listattr.Type[T], insecure func(M) boolattr.Type, h *helpers.Handler) []string {
+	for v := range listattr.Iterator(connectors, h) {
+		var connector M = v
+		if insecure(connector).ValueBool() {
+			names = append(names, connector.GetName().ValueString())
+		}
+	}
+	return names
+}
+
 // Connector Utils
 
 func addConnectorReferences[T any, M helpers.NamedModel[T]](h *helpers.Handler, key string, connectors listattr.Type[T]) {
@@ -87,6 +121,12 @@ func addConnectorNames[T any, M helpers.NamedModel[T]](h *helpers.Handler, names
 	}
 }
 
+func addConnectorKeys[T any, M helpers.KeyedModel[T]](h *helpers.Handler, keys map[string]int, attribute string, connectors listattr.Type[T]) {
+	if !listattr.CollectKeys[T, M](h, keys, connectors) {
+		h.Missing("The 'key' attribute must be set in all objects in the '%s' connectors list", attribute)
+	}
+}
+
 // Sender Field
 
 var SenderFieldAttributes = map[string]schema.Attribute{
@@ -165,8 +205,13 @@ func (m *AuditFilterFieldModel) SetValues(h *helpers.Handler, data map[string]an
 
 // HTTP Headers
 
+// Adds the headers to the list of headers in the configuration, so that the values of
+// a sensitive_headers attribute are merged into the same list as the regular ones.
 func getHeaders(s strmapattr.Type, data map[string]any, key string, h *helpers.Handler) { // nolint:unparam
-	headers := []any{}
+	headers, _ := data[key].([]any)
+	if headers == nil {
+		headers = []any{}
+	}
 	for k, v := range strmapattr.Iterator(s, h) {
 		headers = append(headers, map[string]any{"key": k, "value": v})
 	}
@@ -187,6 +232,80 @@ func setHeaders(s *strmapattr.Type, data map[string]any, key string, _ *helpers.
 	*s = strmapattr.Value(headers)
 }
 
+// Removes the headers that are set in a sensitive_headers attribute from the regular
+// headers that were set from the configuration in a response, and keeps the sensitive
+// values from the plan or state as is.
+func setSensitiveHeaders(s *strmapattr.Type, headers *strmapattr.Type, h *helpers.Handler) {
+	strmapattr.Nil(s, h)
+	if s.IsEmpty() {
+		return
+	}
+	values := map[string]string{}
+	for k, v := range strmapattr.Iterator(*headers, h) {
+		if _, ok := s.Elements()[k]; !ok {
+			values[k] = v
+		}
+	}
+	*headers = strmapattr.Value(values)
+}
+
+// Header names whose values are usually credentials, and which should be set in
+// a sensitive_headers attribute so they aren't shown in plan output.
+var credentialHeaders = []string{"authorization", "proxy-authorization", "cookie", "x-api-key", "api-key", "x-auth-token"}
+
+// Warns when a header that usually carries credentials is set in a regular headers attribute.
+var HeadersValidator validator.Map = &headersValidator{}
+
+// Ensures a header isn't set in both a sensitive_headers attribute and its regular headers sibling.
+var SensitiveHeadersValidator validator.Map = &headersValidator{sensitive: true}
+
+type headersValidator struct {
+	sensitive bool
+}
+
+func (v headersValidator) Description(_ context.Context) string {
+	if v.sensitive {
+		return "must not contain headers that are also set in the regular headers attribute"
+	}
+	return "should not contain headers with credentials"
+}
+
+func (v headersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v headersValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.sensitive {
+		for k := range req.ConfigValue.Elements() {
+			if slices.Contains(credentialHeaders, strings.ToLower(k)) {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Credentials In Headers", fmt.Sprintf("The '%s' header usually contains credentials, and its value will be shown in plan output. Consider moving it to the sensitive_headers attribute instead.", k))
+			}
+		}
+		return
+	}
+
+	step, _ := req.Path.Steps().LastStep()
+	name, ok := step.(path.PathStepAttributeName)
+	if !ok {
+		return
+	}
+	sibling := strings.TrimPrefix(string(name), "sensitive_")
+	var headers strmapattr.Type
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(sibling), &headers)
+	if diags.HasError() || headers.IsNull() || headers.IsUnknown() {
+		return
+	}
+	for k := range req.ConfigValue.Elements() {
+		if _, found := headers.Elements()[k]; found {
+			resp.Diagnostics.AddAttributeError(req.Path, "Conflicting Attribute Values", fmt.Sprintf("The '%s' header cannot be set in both the %s and %s attributes", k, name, sibling))
+		}
+	}
+}
+
 // HTTP Auth Field
 
 var HTTPAuthFieldValidator = objattr.NewValidator[HTTPAuthFieldModel]("must specify exactly one authentication method")
@@ -1,10 +1,14 @@
 package connectors_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/descope/terraform-provider-descope/tools/testacc"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestSCIMConnector(t *testing.T) {
@@ -25,6 +29,9 @@ func TestSCIMConnector(t *testing.T) {
 							headers = {
 								"X-Custom-Header" = "header-value"
 							}
+							sensitive_headers = {
+								"Authorization" = "Bearer test-token"
+							}
 							hmac_secret = "test-hmac-secret"
 							insecure    = true
 						}
@@ -34,15 +41,17 @@ func TestSCIMConnector(t *testing.T) {
 			Check: p.Check(map[string]any{
 				"connectors.scim.#": 1,
 				"connectors.scim.0": map[string]any{
-					"id":                          testacc.AttributeHasPrefix("CI"),
-					"name":                        "My SCIM Connector",
-					"description":                 "A SCIM connector for provisioning",
-					"federated_app_id":            "fake-app-id",
-					"base_url":                    "https://example.com/scim",
-					"authentication.bearer_token": "test-bearer-token",
-					"headers.X-Custom-Header":     "header-value",
-					"insecure":                    true,
-					"disabled":                    false,
+					"id":                              testacc.AttributeHasPrefix("CI"),
+					"name":                            "My SCIM Connector",
+					"description":                     "A SCIM connector for provisioning",
+					"federated_app_id":                "fake-app-id",
+					"base_url":                        "https://example.com/scim",
+					"authentication.bearer_token":     "test-bearer-token",
+					"headers.X-Custom-Header":         "header-value",
+					"headers.Authorization":           testacc.AttributeIsNotSet,
+					"sensitive_headers.Authorization": "Bearer test-token",
+					"insecure":                        true,
+					"disabled":                        false,
 				},
 			}),
 		},
@@ -70,11 +79,32 @@ func TestSCIMConnector(t *testing.T) {
 					"base_url":                    "https://updated.example.com/scim/v2",
 					"authentication.bearer_token": "",
 					"headers.%":                   0,
+					"sensitive_headers.%":         0,
 					"insecure":                    false,
 					"disabled":                    false,
 				},
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				connectors = {
+					"scim": [
+						{
+							name             = "My SCIM Connector"
+							federated_app_id = "fake-app-id"
+							base_url         = "https://example.com/scim"
+							headers = {
+								"Authorization" = "Bearer foo"
+							}
+							sensitive_headers = {
+								"Authorization" = "Bearer bar"
+							}
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`cannot be set in both`),
+		},
 	)
 }
 
@@ -213,3 +243,100 @@ func TestHTTPConnectorEngine(t *testing.T) {
 		},
 	)
 }
+
+func TestConnectorKeys(t *testing.T) {
+	p := testacc.Project(t)
+	connectorID := statecheck.CompareValue(compare.ValuesSame())
+	testacc.Run(t,
+		resource.TestStep{
+			Config: p.Config(`
+				connectors = {
+					"slack": [
+						{
+							key = "alerts"
+							name = "Alerts"
+							token = "foo"
+						}
+					]
+				}
+			`),
+			Check: p.Check(map[string]any{
+				"connectors.slack.#": 1,
+				"connectors.slack.0": map[string]any{
+					"id":   testacc.AttributeHasPrefix("CI"),
+					"key":  "alerts",
+					"name": "Alerts",
+				},
+			}),
+			ConfigStateChecks: []statecheck.StateCheck{
+				connectorID.AddStateValue(p.Path(), tfjsonpath.New("connectors").AtMapKey("slack").AtSliceIndex(0).AtMapKey("id")),
+			},
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				connectors = {
+					"slack": [
+						{
+							key = "new-alerts"
+							name = "New Alerts"
+							token = "foo"
+						},
+						{
+							key = "alerts"
+							name = "Team Alerts"
+							token = "foo"
+						}
+					]
+				}
+			`),
+			Check: p.Check(map[string]any{
+				"connectors.slack.#": 2,
+				"connectors.slack.1": map[string]any{
+					"key":  "alerts",
+					"name": "Team Alerts",
+				},
+			}),
+			ConfigStateChecks: []statecheck.StateCheck{
+				connectorID.AddStateValue(p.Path(), tfjsonpath.New("connectors").AtMapKey("slack").AtSliceIndex(1).AtMapKey("id")),
+			},
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				connectors = {
+					"slack": [
+						{
+							key = "alerts"
+							name = "Alerts"
+							token = "foo"
+						}
+					]
+					"hibp": [
+						{
+							key = "alerts"
+							name = "Breaches"
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`Connector keys must be unique`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				connectors = {
+					"slack": [
+						{
+							key = "alerts"
+							name = "Alerts"
+							token = "foo"
+						},
+						{
+							name = "Other Alerts"
+							token = "foo"
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`must be set in all objects`),
+		},
+	)
+}
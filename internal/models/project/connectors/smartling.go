@@ -10,6 +10,7 @@ import (
 
 var SmartlingAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var SmartlingAttributes = map[string]schema.Attribute{
 
 type SmartlingModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -62,6 +64,10 @@ func (m *SmartlingModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *SmartlingModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SmartlingModel) GetName() stringattr.Type {
 	return m.Name
 }
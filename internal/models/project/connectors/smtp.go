@@ -11,6 +11,7 @@ import (
 
 var SMTPAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -24,6 +25,7 @@ var SMTPAttributes = map[string]schema.Attribute{
 
 type SMTPModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -69,6 +71,10 @@ func (m *SMTPModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *SMTPModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SMTPModel) GetName() stringattr.Type {
 	return m.Name
 }
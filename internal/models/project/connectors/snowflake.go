@@ -16,6 +16,7 @@ var SnowflakeValidator = objattr.NewValidator[SnowflakeModel]("must have a valid
 
 var SnowflakeAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -36,6 +37,7 @@ var SnowflakeAttributes = map[string]schema.Attribute{
 
 type SnowflakeModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -109,6 +111,10 @@ func (m *SnowflakeModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *SnowflakeModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SnowflakeModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -12,6 +12,7 @@ var SNSValidator = objattr.NewValidator[SNSModel]("must have a valid configurati
 
 var SNSAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -35,6 +36,7 @@ var SNSAttributes = map[string]schema.Attribute{
 
 type SNSModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -144,6 +146,10 @@ func (m *SNSModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *SNSModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SNSModel) GetName() stringattr.Type {
 	return m.Name
 }
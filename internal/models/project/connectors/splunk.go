@@ -15,6 +15,7 @@ var SplunkValidator = objattr.NewValidator[SplunkModel]("must have a valid confi
 
 var SplunkAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -30,6 +31,7 @@ var SplunkAttributes = map[string]schema.Attribute{
 
 type SplunkModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -85,6 +87,10 @@ func (m *SplunkModel) SetConfigurationValues(c map[string]any, h *helpers.Handle
 
 // Matching
 
+func (m *SplunkModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SplunkModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -17,6 +17,7 @@ var SQLValidator = objattr.NewValidator[SQLModel]("must have a valid configurati
 
 var SQLAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -33,6 +34,7 @@ var SQLAttributes = map[string]schema.Attribute{
 
 type SQLModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -94,6 +96,10 @@ func (m *SQLModel) SetConfigurationValues(c map[string]any, h *helpers.Handler)
 
 // Matching
 
+func (m *SQLModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SQLModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -15,6 +15,7 @@ var SumoLogicValidator = objattr.NewValidator[SumoLogicModel]("must have a valid
 
 var SumoLogicAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -28,6 +29,7 @@ var SumoLogicAttributes = map[string]schema.Attribute{
 
 type SumoLogicModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -77,6 +79,10 @@ func (m *SumoLogicModel) SetConfigurationValues(c map[string]any, h *helpers.Han
 
 // Matching
 
+func (m *SumoLogicModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SumoLogicModel) GetName() stringattr.Type {
 	return m.Name
 }
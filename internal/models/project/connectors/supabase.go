@@ -17,6 +17,7 @@ var SupabaseValidator = objattr.NewValidator[SupabaseModel]("must have a valid c
 
 var SupabaseAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -34,6 +35,7 @@ var SupabaseAttributes = map[string]schema.Attribute{
 
 type SupabaseModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -116,6 +118,10 @@ func (m *SupabaseModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *SupabaseModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *SupabaseModel) GetName() stringattr.Type {
 	return m.Name
 }
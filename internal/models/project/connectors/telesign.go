@@ -10,6 +10,7 @@ import (
 
 var TelesignAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -21,6 +22,7 @@ var TelesignAttributes = map[string]schema.Attribute{
 
 type TelesignModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -58,6 +60,10 @@ func (m *TelesignModel) SetConfigurationValues(c map[string]any, h *helpers.Hand
 
 // Matching
 
+func (m *TelesignModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *TelesignModel) GetName() stringattr.Type {
 	return m.Name
 }
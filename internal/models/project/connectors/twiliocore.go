@@ -9,6 +9,7 @@ import (
 
 var TwilioCoreAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -21,6 +22,7 @@ var TwilioCoreAttributes = map[string]schema.Attribute{
 
 type TwilioCoreModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -61,6 +63,10 @@ func (m *TwilioCoreModel) SetConfigurationValues(c map[string]any, h *helpers.Ha
 
 // Matching
 
+func (m *TwilioCoreModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *TwilioCoreModel) GetName() stringattr.Type {
 	return m.Name
 }
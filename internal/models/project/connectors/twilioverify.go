@@ -9,6 +9,7 @@ import (
 
 var TwilioVerifyAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -22,6 +23,7 @@ var TwilioVerifyAttributes = map[string]schema.Attribute{
 
 type TwilioVerifyModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -65,6 +67,10 @@ func (m *TwilioVerifyModel) SetConfigurationValues(c map[string]any, h *helpers.
 
 // Matching
 
+func (m *TwilioVerifyModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *TwilioVerifyModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -10,6 +10,7 @@ import (
 
 var UnibeamAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringattr.StandardLenValidator),
 	"description": stringattr.Default(""),
 
@@ -25,6 +26,7 @@ var UnibeamAttributes = map[string]schema.Attribute{
 
 type UnibeamModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 
@@ -74,6 +76,10 @@ func (m *UnibeamModel) SetConfigurationValues(c map[string]any, h *helpers.Handl
 
 // Matching
 
+func (m *UnibeamModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *UnibeamModel) GetName() stringattr.Type {
 	return m.Name
 }
@@ -40,10 +40,11 @@ func (m *FlowModel) Check(h *helpers.Handler) {
 	ensureReferences(data, "roles", "role", helpers.RoleReferenceKey, h)
 }
 
-func getFlowData(data stringattr.Type, _ *helpers.Handler) map[string]any {
+func getFlowData(data stringattr.Type, h *helpers.Handler) map[string]any {
 	m := map[string]any{}
 	if err := json.Unmarshal([]byte(data.ValueString()), &m); err != nil {
-		panic("Invalid flow data after validation: " + err.Error())
+		h.Error("Invalid flow data", "Failed to parse JSON: %s", err.Error())
+		return nil
 	}
 	return m
 }
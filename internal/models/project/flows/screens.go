@@ -0,0 +1,66 @@
+package flows
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+)
+
+// The component properties that hold text that's shown to the user in a screen.
+var screenTextProperties = []string{"text", "label", "placeholder", "title", "description"}
+
+// Returns the keys of the texts in each screen of the flow, keyed by the screen id, or nil if the
+// flow data isn't known yet or isn't valid JSON. A text key is made of the id of a component in
+// the screen's template and the property that holds the text, e.g., 'emailInput.label'.
+func (m *FlowModel) ScreenTexts() map[string][]string {
+	if m.Data.IsUnknown() || m.Data.IsNull() {
+		return nil
+	}
+	data := map[string]any{}
+	if err := json.Unmarshal([]byte(m.Data.ValueString()), &data); err != nil {
+		return nil // reported by the JSON validator
+	}
+
+	result := map[string][]string{}
+	screens, _ := data["screens"].([]any)
+	for _, s := range screens {
+		screen, _ := s.(map[string]any)
+		id, _ := screen["id"].(string)
+		if id == "" {
+			continue
+		}
+		keys := map[string]bool{}
+		collectScreenTexts(screen["htmlTemplate"], keys)
+		result[id] = slices.Sorted(maps.Keys(keys))
+	}
+	return result
+}
+
+// Adds the text keys of a component and of all its nested components, where the properties of
+// a component can either be set directly on it or in its 'props' object.
+func collectScreenTexts(value any, keys map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		props, _ := v["props"].(map[string]any)
+		id, _ := v["id"].(string)
+		if id == "" {
+			id, _ = props["id"].(string)
+		}
+		if id != "" {
+			for _, prop := range screenTextProperties {
+				if s, _ := v[prop].(string); s != "" {
+					keys[id+"."+prop] = true
+				} else if s, _ := props[prop].(string); s != "" {
+					keys[id+"."+prop] = true
+				}
+			}
+		}
+		for _, item := range v {
+			collectScreenTexts(item, keys)
+		}
+	case []any:
+		for _, item := range v {
+			collectScreenTexts(item, keys)
+		}
+	}
+}
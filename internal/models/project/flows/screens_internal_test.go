@@ -0,0 +1,39 @@
+package flows
+
+import (
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScreenTexts(t *testing.T) {
+	m := &FlowModel{Data: stringattr.Value(`{
+		"flowId": "sign-in",
+		"screens": [
+			{
+				"id": "welcome",
+				"htmlTemplate": {
+					"type": "root",
+					"children": [
+						{"id": "heading", "type": "text", "text": "Welcome"},
+						{"type": "container", "children": [
+							{"type": "input", "props": {"id": "email", "label": "Email", "placeholder": "you@example.com"}},
+							{"id": "submit", "type": "button", "text": "Continue", "label": ""}
+						]},
+						{"type": "text", "text": "No id"}
+					]
+				}
+			},
+			{"id": "empty"},
+			{"htmlTemplate": {"id": "orphan", "text": "No screen id"}}
+		]
+	}`)}
+	assert.Equal(t, map[string][]string{
+		"welcome": {"email.label", "email.placeholder", "heading.text", "submit.text"},
+		"empty":   nil,
+	}, m.ScreenTexts())
+
+	assert.Equal(t, map[string][]string{}, (&FlowModel{Data: stringattr.Value(`{"flowId": "foo"}`)}).ScreenTexts())
+	assert.Nil(t, (&FlowModel{Data: stringattr.Value(`not json`)}).ScreenTexts())
+}
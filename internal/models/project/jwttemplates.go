@@ -0,0 +1,59 @@
+package project
+
+import (
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// Checks that the custom attributes used in the dynamic values of JWT templates are defined in
+// the project's user and tenant attributes, as otherwise the claims will silently be empty. The
+// attributes aren't managed by the project resource unless they're set in the configuration,
+// so only the user or tenant attributes that are in the configuration are checked.
+func (m *ProjectModel) validateJWTTemplateAttributes(h *helpers.Handler) {
+	templates, _ := m.JWTTemplates.ToObject(h.Ctx)
+	if templates == nil || m.Attributes.IsNull() || m.Attributes.IsUnknown() {
+		return
+	}
+
+	attrs, _ := m.Attributes.ToObject(h.Ctx)
+	if attrs == nil || helpers.HasUnknownValues(attrs.User, attrs.Tenant) {
+		return
+	}
+
+	defined := map[string]map[string]bool{}
+	if !attrs.User.IsNull() {
+		if defined["user"] = attributeIDs(h, attrs.User); defined["user"] == nil {
+			return
+		}
+	}
+	if !attrs.Tenant.IsNull() {
+		if defined["tenant"] = attributeIDs(h, attrs.Tenant); defined["tenant"] == nil {
+			return
+		}
+	}
+
+	for _, p := range templates.Placeholders(h) {
+		if p.Attribute == "" || defined[p.Scope] == nil {
+			continue
+		}
+		if !defined[p.Scope][p.Attribute] {
+			h.Error("Unknown JWT Template Attribute", "The '%s' claim in the JWT template '%s' uses the dynamic value '%s' but no %s attribute with the id '%s' is defined in the project's attributes", p.Claim, p.Template, p.Value, p.Scope, p.Attribute)
+		}
+	}
+}
+
+// Returns the ids of the attributes in the list, or nil if any of them can't be determined yet.
+func attributeIDs[T any, M interface {
+	*T
+	AttributeID() string
+}](h *helpers.Handler, attrs listattr.Type[T]) map[string]bool {
+	ids := map[string]bool{}
+	for attr := range listattr.Iterator(attrs, h) {
+		id := M(attr).AttributeID()
+		if id == "" {
+			return nil
+		}
+		ids[id] = true
+	}
+	return ids
+}
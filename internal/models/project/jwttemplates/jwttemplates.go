@@ -44,9 +44,11 @@ func (m *JWTTemplatesModel) Validate(h *helpers.Handler) {
 	names := map[string]int{}
 	for v := range listattr.Iterator(m.UserTemplates, h) {
 		names[v.Name.ValueString()] += 1
+		v.lint(h)
 	}
 	for v := range listattr.Iterator(m.AccessKeyTemplates, h) {
 		names[v.Name.ValueString()] += 1
+		v.lint(h)
 	}
 	for k, v := range names {
 		if v > 1 {
@@ -54,3 +56,15 @@ func (m *JWTTemplatesModel) Validate(h *helpers.Handler) {
 		}
 	}
 }
+
+// Returns the dynamic values used in all the JWT templates.
+func (m *JWTTemplatesModel) Placeholders(h *helpers.Handler) []*Placeholder {
+	result := []*Placeholder{}
+	for v := range listattr.Iterator(m.UserTemplates, h) {
+		result = append(result, v.Placeholders()...)
+	}
+	for v := range listattr.Iterator(m.AccessKeyTemplates, h) {
+		result = append(result, v.Placeholders()...)
+	}
+	return result
+}
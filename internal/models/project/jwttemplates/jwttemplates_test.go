@@ -21,6 +21,40 @@ func TestJWTTemplates(t *testing.T) {
 				"jwt_templates.user_templates.#": 0,
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				jwt_templates = {
+					user_templates = [
+						{
+							name = "foo"
+							template = jsonencode({ exp = 0, sub = "@user.email" })
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`(?s)'exp' claim which is always set by Descope.*'sub' claim which is ignored`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				attributes = {
+					user = [
+						{
+							name = "Department"
+							type = "string"
+						}
+					]
+				}
+				jwt_templates = {
+					user_templates = [
+						{
+							name = "foo"
+							template = jsonencode({ dept = "@user.customAttributes.department", team = "@user.customAttributes.team" })
+						}
+					]
+				}
+			`),
+			ExpectError: regexp.MustCompile(`no user attribute with the id 'team'`),
+		},
 		resource.TestStep{
 			Config: p.Config(`
 				jwt_templates = {
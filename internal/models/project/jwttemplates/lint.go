@@ -0,0 +1,174 @@
+package jwttemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// Claims that are always set by Descope when the JWT is created, and which the server silently
+// ignores if they appear in a template, with a hint about the setting to use instead if any.
+var reservedClaims = map[string]string{
+	"exp":  "",
+	"iat":  "",
+	"nbf":  "",
+	"iss":  "Use the conformance_issuer and enforce_issuer settings to control the issuer claim instead.",
+	"jti":  "Use the add_jti_claim setting to add a unique identifier claim instead.",
+	"amr":  "",
+	"drn":  "",
+	"rexp": "",
+}
+
+// The built-in fields that can be used in dynamic values, e.g., @user.email.
+var placeholderFields = map[string][]string{
+	"user":   {"userId", "loginIds", "email", "phone", "name", "givenName", "middleName", "familyName", "picture", "verifiedEmail", "verifiedPhone", "status", "roles", "tenants", "externalIds", "ssoAppIds"},
+	"tenant": {"id", "name", "roles", "selfProvisioningDomains"},
+}
+
+const customAttributesField = "customAttributes"
+
+var placeholderRegexp = regexp.MustCompile(`^@([A-Za-z]+)\.([A-Za-z0-9_.\[\]-]+)$`)
+
+var placeholderPartRegexp = regexp.MustCompile(`^[^.\[]*`)
+
+// A dynamic value in a JWT template claim, e.g., @user.customAttributes.department.
+type Placeholder struct {
+	Template  string // the name of the JWT template
+	Claim     string // the path to the claim in the template, e.g., 'org.department'
+	Value     string // the dynamic value, e.g., '@user.customAttributes.department'
+	Scope     string // the object the value is taken from, e.g., 'user' or 'tenant'
+	Field     string // the built-in field or 'customAttributes'
+	Attribute string // the key of the custom attribute, if the field is 'customAttributes'
+}
+
+// Returns the dynamic values used in the template claims, or nil if the template isn't known
+// yet or isn't a valid JSON object.
+func (m *JWTTemplateModel) Placeholders() []*Placeholder {
+	claims := m.claims()
+	if claims == nil {
+		return nil
+	}
+	result := []*Placeholder{}
+	walkClaims(claims, "", func(claim string, value any) {
+		s, _ := value.(string)
+		match := placeholderRegexp.FindStringSubmatch(s)
+		if match == nil {
+			return
+		}
+		p := &Placeholder{Template: m.Name.ValueString(), Claim: claim, Value: s, Scope: match[1]}
+		p.Field = placeholderPartRegexp.FindString(match[2])
+		if rest, ok := strings.CutPrefix(match[2], customAttributesField+"."); ok {
+			p.Attribute = placeholderPartRegexp.FindString(rest)
+		}
+		result = append(result, p)
+	})
+	return result
+}
+
+// Checks the template claims against the claims that Descope sets on its own and the dynamic
+// value syntax, taking into account the other settings of the template.
+func (m *JWTTemplateModel) lint(h *helpers.Handler) {
+	claims := m.claims()
+	if claims == nil || helpers.HasUnknownValues(m.AuthSchema, m.EmptyClaimPolicy, m.AutoDCT, m.ExcludePermissionClaim, m.OverrideSubjectClaim) {
+		return
+	}
+
+	name := m.Name.ValueString()
+	for _, claim := range slices.Sorted(maps.Keys(claims)) {
+		if hint, ok := reservedClaims[claim]; ok {
+			h.Error("Reserved JWT Claim", "%s", strings.TrimSpace(fmt.Sprintf("The JWT template '%s' sets the '%s' claim which is always set by Descope and would be ignored. %s", name, claim, hint)))
+		}
+	}
+
+	if _, ok := claims["sub"]; ok && !m.OverrideSubjectClaim.ValueBool() {
+		h.Error("Reserved JWT Claim", "The JWT template '%s' sets the 'sub' claim which is ignored unless override_subject_claim is set to true", name)
+	} else if !ok && m.OverrideSubjectClaim.ValueBool() {
+		h.Warn("Missing Subject Claim", "The JWT template '%s' has override_subject_claim set to true but doesn't set the 'sub' claim", name)
+	}
+
+	authSchema := m.AuthSchema.ValueString()
+	for _, claim := range authorizationClaims(authSchema, m.ExcludePermissionClaim.ValueBool()) {
+		if _, ok := claims[claim]; ok {
+			h.Error("Reserved JWT Claim", "The JWT template '%s' sets the '%s' claim which is set by Descope when auth_schema is '%s'", name, claim, authSchema)
+		}
+	}
+	if authSchema == "none" && m.ExcludePermissionClaim.ValueBool() {
+		h.Warn("Ineffective JWT Template Setting", "The JWT template '%s' has exclude_permission_claim set to true which has no effect when auth_schema is 'none'", name)
+	}
+
+	if _, ok := claims["dct"]; ok && m.AutoDCT.ValueBool() {
+		h.Error("Reserved JWT Claim", "The JWT template '%s' sets the 'dct' claim which is set by Descope when auto_tenant_claim is set to true", name)
+	}
+
+	if m.EmptyClaimPolicy.ValueString() == "delete" {
+		walkClaims(claims, "", func(claim string, value any) {
+			if value == nil || value == "" {
+				h.Warn("Empty JWT Claim", "The '%s' claim in the JWT template '%s' is empty and will always be removed because empty_claim_policy is 'delete'", claim, name)
+			}
+		})
+	}
+
+	for _, p := range m.Placeholders() {
+		fields, ok := placeholderFields[p.Scope]
+		if !ok {
+			h.Warn("Unknown JWT Template Value", "The '%s' claim in the JWT template '%s' uses the dynamic value '%s' which doesn't refer to the user or tenant", p.Claim, name, p.Value)
+		} else if p.Field == customAttributesField {
+			if p.Attribute == "" {
+				h.Error("Invalid JWT Template Value", "The '%s' claim in the JWT template '%s' uses the dynamic value '%s' which is missing the custom attribute key", p.Claim, name, p.Value)
+			}
+		} else if !slices.Contains(fields, p.Field) {
+			h.Warn("Unknown JWT Template Value", "The '%s' claim in the JWT template '%s' uses the dynamic value '%s' which doesn't refer to a known %s field", p.Claim, name, p.Value, p.Scope)
+		}
+	}
+}
+
+// Returns the top level claims that Descope adds for the authorization schema.
+func authorizationClaims(authSchema string, excludePermissions bool) []string {
+	switch authSchema {
+	case "default":
+		if excludePermissions {
+			return []string{"roles", "tenants"}
+		}
+		return []string{"permissions", "roles", "tenants"}
+	case "tenantOnly":
+		return []string{"tenants"}
+	}
+	return nil
+}
+
+func (m *JWTTemplateModel) claims() map[string]any {
+	if m.Template.IsUnknown() || m.Template.IsNull() {
+		return nil
+	}
+	claims := map[string]any{}
+	if err := json.Unmarshal([]byte(m.Template.ValueString()), &claims); err != nil {
+		return nil // reported by the JSON validator
+	}
+	return claims
+}
+
+// Calls the function with the path and value of every claim in the template, including the
+// ones in nested objects and arrays.
+func walkClaims(value any, path string, f func(claim string, value any)) {
+	switch v := value.(type) {
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			walkClaims(v[k], p, f)
+		}
+	case []any:
+		for i, item := range v {
+			walkClaims(item, fmt.Sprintf("%s[%d]", path, i), f)
+		}
+	default:
+		f(path, v)
+	}
+}
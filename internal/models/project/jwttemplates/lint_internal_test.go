@@ -0,0 +1,98 @@
+package jwttemplates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplate(template string) *JWTTemplateModel {
+	return &JWTTemplateModel{
+		Name:                   stringattr.Value("foo"),
+		AuthSchema:             stringattr.Value("default"),
+		EmptyClaimPolicy:       stringattr.Value("none"),
+		AutoDCT:                boolattr.Value(false),
+		ExcludePermissionClaim: boolattr.Value(false),
+		OverrideSubjectClaim:   boolattr.Value(false),
+		Template:               stringattr.Value(template),
+	}
+}
+
+func lintTemplate(m *JWTTemplateModel) (errors []string, warnings []string) {
+	diags := diag.Diagnostics{}
+	m.lint(helpers.NewHandler(context.Background(), &diags))
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityError {
+			errors = append(errors, d.Detail())
+		} else {
+			warnings = append(warnings, d.Detail())
+		}
+	}
+	return
+}
+
+func TestLintReservedClaims(t *testing.T) {
+	errors, warnings := lintTemplate(newTemplate(`{"exp": 1, "jti": "x", "sub": "y", "roles": [], "dept": "a"}`))
+	require.Len(t, errors, 4)
+	assert.Empty(t, warnings)
+	assert.Contains(t, errors[0], "sets the 'exp' claim which is always set by Descope")
+	assert.Contains(t, errors[1], "add_jti_claim")
+	assert.Contains(t, errors[2], "'sub' claim which is ignored unless override_subject_claim")
+	assert.Contains(t, errors[3], "'roles' claim which is set by Descope when auth_schema is 'default'")
+
+	m := newTemplate(`{"sub": "@user.email", "roles": [], "permissions": []}`)
+	m.OverrideSubjectClaim = boolattr.Value(true)
+	m.AuthSchema = stringattr.Value("tenantOnly")
+	errors, warnings = lintTemplate(m)
+	assert.Empty(t, errors)
+	assert.Empty(t, warnings)
+
+	m = newTemplate(`{"permissions": [], "dct": "t1"}`)
+	m.ExcludePermissionClaim = boolattr.Value(true)
+	errors, _ = lintTemplate(m)
+	assert.Empty(t, errors)
+
+	m.AutoDCT = boolattr.Value(true)
+	errors, _ = lintTemplate(m)
+	require.Len(t, errors, 1)
+	assert.Contains(t, errors[0], "'dct' claim")
+}
+
+func TestLintSettings(t *testing.T) {
+	m := newTemplate(`{"a": "", "b": {"c": null, "d": "x"}}`)
+	m.AuthSchema = stringattr.Value("none")
+	m.ExcludePermissionClaim = boolattr.Value(true)
+	m.OverrideSubjectClaim = boolattr.Value(true)
+	m.EmptyClaimPolicy = stringattr.Value("delete")
+	errors, warnings := lintTemplate(m)
+	assert.Empty(t, errors)
+	require.Len(t, warnings, 4)
+	assert.Contains(t, warnings[0], "doesn't set the 'sub' claim")
+	assert.Contains(t, warnings[1], "no effect when auth_schema is 'none'")
+	assert.Contains(t, warnings[2], "The 'a' claim")
+	assert.Contains(t, warnings[3], "The 'b.c' claim")
+}
+
+func TestLintPlaceholders(t *testing.T) {
+	m := newTemplate(`{"org": {"dept": "@user.customAttributes.department", "ids": ["@tenant.id", "@tenant.customAttributes"]}, "mail": "@user.mail", "tid": "@user.tenants[0].tenantId", "x": "@session.id", "y": "@handle"}`)
+
+	placeholders := m.Placeholders()
+	require.Len(t, placeholders, 6)
+	assert.Equal(t, &Placeholder{Template: "foo", Claim: "org.dept", Value: "@user.customAttributes.department", Scope: "user", Field: "customAttributes", Attribute: "department"}, placeholders[1])
+	assert.Equal(t, "org.ids[0]", placeholders[2].Claim)
+
+	errors, warnings := lintTemplate(m)
+	require.Len(t, errors, 1)
+	assert.Contains(t, errors[0], "'@tenant.customAttributes' which is missing the custom attribute key")
+	require.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "'@user.mail' which doesn't refer to a known user field")
+	assert.Contains(t, warnings[1], "'@session.id' which doesn't refer to the user or tenant")
+
+	assert.Nil(t, newTemplate(`not json`).Placeholders())
+}
@@ -0,0 +1,194 @@
+package jwttemplates
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// The value of the jti claim in rendered claims, as the actual value is generated for each token.
+const RenderedJTI = "<generated>"
+
+// The sample objects that a JWT template is rendered with. The subject is either a user or an
+// access key, with the same fields that dynamic values refer to, e.g., 'userId', 'email' and
+// 'customAttributes' for a user, along with 'roles', 'permissions' and a 'tenants' list whose
+// items have 'tenantId', 'roles' and 'permissions' fields.
+type Sample struct {
+	ProjectID string
+	User      map[string]any
+	AccessKey map[string]any
+	Tenant    map[string]any
+}
+
+var placeholderPathRegexp = regexp.MustCompile(`[^.\[\]]+|\[\d+\]`)
+
+// Returns the claims that Descope would add to a JWT created with the template for the sample
+// subject. Claims that depend on the time the token is created, such as 'exp' and 'iat', aren't
+// included as they're different for each token.
+func (m *JWTTemplateModel) Render(sample *Sample) (map[string]any, error) {
+	if helpers.HasUnknownValues(m.Template, m.AuthSchema, m.EmptyClaimPolicy, m.AutoDCT, m.ConformanceIssuer, m.ExcludePermissionClaim, m.OverrideSubjectClaim, m.AddJtiClaim) {
+		return nil, errors.New("the JWT template settings must be known to render it")
+	}
+	claims := m.claims()
+	if claims == nil {
+		return nil, errors.New("the JWT template must be a valid JSON object")
+	}
+
+	subject, subjectID := sample.User, "userId"
+	if subject == nil {
+		subject, subjectID = sample.AccessKey, "id"
+	}
+	if subject == nil {
+		return nil, errors.New("either a user or an access key is required to render the JWT template")
+	}
+
+	// claims that Descope sets on its own are ignored when they appear in the template
+	for claim := range reservedClaims {
+		delete(claims, claim)
+	}
+	for _, claim := range authorizationClaims(m.AuthSchema.ValueString(), m.ExcludePermissionClaim.ValueBool()) {
+		delete(claims, claim)
+	}
+	if m.AutoDCT.ValueBool() {
+		delete(claims, "dct")
+	}
+	sub, overrideSubject := claims["sub"]
+	overrideSubject = overrideSubject && m.OverrideSubjectClaim.ValueBool()
+	delete(claims, "sub")
+
+	result := renderClaims(claims, sample, m.EmptyClaimPolicy.ValueString()).(map[string]any)
+
+	id, _ := subject[subjectID].(string)
+	if overrideSubject {
+		if value := renderClaims(sub, sample, m.EmptyClaimPolicy.ValueString()); value != nil && value != "" {
+			result["sub"] = value
+		}
+		result["dsub"] = id
+	} else {
+		result["sub"] = id
+	}
+
+	if sample.ProjectID != "" {
+		if m.ConformanceIssuer.ValueBool() {
+			result["iss"] = "https://api.descope.com/" + sample.ProjectID
+		} else {
+			result["iss"] = sample.ProjectID
+		}
+	}
+
+	if m.AddJtiClaim.ValueBool() {
+		result["jti"] = RenderedJTI
+	}
+
+	tenants, _ := subject["tenants"].([]any)
+	if m.AutoDCT.ValueBool() && len(tenants) == 1 {
+		if tenant, ok := tenants[0].(map[string]any); ok {
+			result["dct"] = tenant["tenantId"]
+		}
+	}
+
+	excludePermissions := m.ExcludePermissionClaim.ValueBool()
+	switch m.AuthSchema.ValueString() {
+	case "default":
+		result["roles"] = stringList(subject["roles"])
+		if !excludePermissions {
+			result["permissions"] = stringList(subject["permissions"])
+		}
+		fallthrough
+	case "tenantOnly":
+		if claim := tenantsClaim(tenants, excludePermissions); len(claim) > 0 {
+			result["tenants"] = claim
+		}
+	}
+
+	return result, nil
+}
+
+// Resolves the dynamic values in the claims and applies the empty claim policy to claims whose
+// value is empty.
+func renderClaims(value any, sample *Sample, emptyClaimPolicy string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for k, item := range v {
+			item = renderClaims(item, sample, emptyClaimPolicy)
+			if item == nil || item == "" {
+				switch emptyClaimPolicy {
+				case "delete":
+					continue
+				case "nil":
+					item = nil
+				default:
+					item = ""
+				}
+			}
+			result[k] = item
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, renderClaims(item, sample, emptyClaimPolicy))
+		}
+		return result
+	case string:
+		match := placeholderRegexp.FindStringSubmatch(v)
+		if match == nil {
+			return v
+		}
+		switch match[1] {
+		case "user":
+			return resolvePath(sample.User, match[2])
+		case "tenant":
+			return resolvePath(sample.Tenant, match[2])
+		}
+		return v
+	}
+	return value
+}
+
+// Returns the value at a path such as 'tenants[0].tenantId' in the object, or nil if it's missing.
+func resolvePath(object map[string]any, path string) any {
+	var value any = object
+	for _, part := range placeholderPathRegexp.FindAllString(path, -1) {
+		if strings.HasPrefix(part, "[") {
+			index, _ := strconv.Atoi(strings.Trim(part, "[]"))
+			list, _ := value.([]any)
+			if index >= len(list) {
+				return nil
+			}
+			value = list[index]
+		} else {
+			m, _ := value.(map[string]any)
+			value = m[part]
+		}
+	}
+	return value
+}
+
+func tenantsClaim(tenants []any, excludePermissions bool) map[string]any {
+	result := map[string]any{}
+	for _, t := range tenants {
+		tenant, _ := t.(map[string]any)
+		id, _ := tenant["tenantId"].(string)
+		if id == "" {
+			continue
+		}
+		claim := map[string]any{"roles": stringList(tenant["roles"])}
+		if !excludePermissions {
+			claim["permissions"] = stringList(tenant["permissions"])
+		}
+		result[id] = claim
+	}
+	return result
+}
+
+func stringList(value any) []any {
+	if list, ok := value.([]any); ok {
+		return list
+	}
+	return []any{}
+}
@@ -0,0 +1,67 @@
+package jwttemplates
+
+import (
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sampleUser = map[string]any{
+	"userId":           "U1",
+	"email":            "jane@example.com",
+	"roles":            []any{"viewer"},
+	"permissions":      []any{"read"},
+	"tenants":          []any{map[string]any{"tenantId": "T1", "roles": []any{"admin"}, "permissions": []any{"write"}}},
+	"customAttributes": map[string]any{"plan": "pro"},
+}
+
+func TestRender(t *testing.T) {
+	m := newTemplate(`{"exp": 1, "sub": "x", "mail": "@user.email", "org": {"plan": "@user.customAttributes.plan", "tid": "@user.tenants[0].tenantId", "region": "@tenant.customAttributes.region"}, "dept": "@user.customAttributes.dept"}`)
+	claims, err := m.Render(&Sample{ProjectID: "P1", User: sampleUser})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"sub":         "U1",
+		"iss":         "P1",
+		"mail":        "jane@example.com",
+		"org":         map[string]any{"plan": "pro", "tid": "T1", "region": ""},
+		"dept":        "",
+		"roles":       []any{"viewer"},
+		"permissions": []any{"read"},
+		"tenants":     map[string]any{"T1": map[string]any{"roles": []any{"admin"}, "permissions": []any{"write"}}},
+	}, claims)
+
+	m = newTemplate(`{"sub": "@user.email", "dept": "@user.customAttributes.dept", "region": "@tenant.customAttributes.region"}`)
+	m.AuthSchema = stringattr.Value("tenantOnly")
+	m.EmptyClaimPolicy = stringattr.Value("delete")
+	m.ExcludePermissionClaim = boolattr.Value(true)
+	m.OverrideSubjectClaim = boolattr.Value(true)
+	m.ConformanceIssuer = boolattr.Value(true)
+	m.AutoDCT = boolattr.Value(true)
+	m.AddJtiClaim = boolattr.Value(true)
+	claims, err = m.Render(&Sample{ProjectID: "P1", User: sampleUser, Tenant: map[string]any{"customAttributes": map[string]any{"region": "eu"}}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"sub":     "jane@example.com",
+		"dsub":    "U1",
+		"iss":     "https://api.descope.com/P1",
+		"jti":     RenderedJTI,
+		"dct":     "T1",
+		"region":  "eu",
+		"tenants": map[string]any{"T1": map[string]any{"roles": []any{"admin"}}},
+	}, claims)
+
+	m = newTemplate(`{"env": "staging", "missing": "@user.name"}`)
+	m.AuthSchema = stringattr.Value("none")
+	m.EmptyClaimPolicy = stringattr.Value("nil")
+	claims, err = m.Render(&Sample{AccessKey: map[string]any{"id": "K1"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"sub": "K1", "env": "staging", "missing": nil}, claims)
+
+	_, err = newTemplate(`{}`).Render(&Sample{})
+	assert.ErrorContains(t, err, "either a user or an access key is required")
+	_, err = newTemplate(`[]`).Render(&Sample{User: sampleUser})
+	assert.ErrorContains(t, err, "must be a valid JSON object")
+}
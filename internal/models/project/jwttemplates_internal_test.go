@@ -0,0 +1,47 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/attributes"
+	"github.com/descope/terraform-provider-descope/internal/models/project/jwttemplates"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateJWTTemplateAttributes(t *testing.T) {
+	validate := func(userAttributes []string, template string) (errors []string) {
+		m := &ProjectModel{
+			JWTTemplates: objattr.Value(&jwttemplates.JWTTemplatesModel{
+				UserTemplates: listattr.Value([]*jwttemplates.JWTTemplateModel{{Name: stringattr.Value("test"), Template: stringattr.Value(template)}}),
+			}),
+		}
+		if userAttributes != nil {
+			user := []*attributes.UserAttributeModel{}
+			for _, id := range userAttributes {
+				user = append(user, &attributes.UserAttributeModel{AttributeModel: attributes.AttributeModel{ID: stringattr.Value(id)}})
+			}
+			m.Attributes = objattr.Value(&attributes.AttributesModel{User: listattr.Value(user)})
+		}
+		diags := diag.Diagnostics{}
+		m.validateJWTTemplateAttributes(helpers.NewHandler(context.Background(), &diags))
+		for _, d := range diags.Errors() {
+			errors = append(errors, d.Detail())
+		}
+		return
+	}
+
+	// the attributes aren't checked when they're not in the configuration
+	assert.Empty(t, validate(nil, `{"dept":"@user.customAttributes.department"}`))
+	assert.Empty(t, validate([]string{"department"}, `{"dept":"@user.customAttributes.department","org":"@tenant.customAttributes.region"}`))
+
+	errors := validate([]string{"department"}, `{"email":"@user.email","team":"@user.customAttributes.team"}`)
+	require.Len(t, errors, 1)
+	assert.Equal(t, "The 'team' claim in the JWT template 'test' uses the dynamic value '@user.customAttributes.team' but no user attribute with the id 'team' is defined in the project's attributes", errors[0])
+}
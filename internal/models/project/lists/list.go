@@ -12,12 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
-var ListsModifier = listattr.NewModifierMatchingNames[ListModel]("maintains list identifiers between plan changes")
+var ListsModifier = listattr.NewModifierMatchingKeysOrNames[ListModel]("maintains list identifiers between plan changes")
 
 var ListValidator = objattr.NewValidator[ListModel]("must have a valid data value")
 
 var ListAttributes = map[string]schema.Attribute{
 	"id":          stringattr.IdentifierMatched(),
+	"key":         stringattr.Default(""),
 	"name":        stringattr.Required(stringvalidator.LengthAtMost(100)),
 	"description": stringattr.Default("", stringattr.StandardLenValidator),
 	"type":        stringattr.Required(stringvalidator.OneOf("texts", "ips", "json")),
@@ -26,6 +27,7 @@ var ListAttributes = map[string]schema.Attribute{
 
 type ListModel struct {
 	ID          stringattr.Type `tfsdk:"id"`
+	Key         stringattr.Type `tfsdk:"key"`
 	Name        stringattr.Type `tfsdk:"name"`
 	Description stringattr.Type `tfsdk:"description"`
 	Type        stringattr.Type `tfsdk:"type"`
@@ -122,6 +124,10 @@ func isPermittedIPValid(ipOrCIDR string) bool {
 
 // Matching
 
+func (m *ListModel) GetKey() stringattr.Type {
+	return m.Key
+}
+
 func (m *ListModel) GetName() stringattr.Type {
 	return m.Name
 }
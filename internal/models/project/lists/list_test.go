@@ -7,7 +7,10 @@ import (
 	"testing"
 
 	"github.com/descope/terraform-provider-descope/tools/testacc"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 var (
@@ -23,6 +26,7 @@ var (
 
 func TestLists(t *testing.T) {
 	p := testacc.Project(t)
+	listID := statecheck.CompareValue(compare.ValuesSame())
 	testacc.Run(t,
 		resource.TestStep{
 			Config: p.Config(`
@@ -135,6 +139,75 @@ func TestLists(t *testing.T) {
 			`),
 			ExpectError: regexp.MustCompile(`Invalid Attribute Value Length`),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				lists = [
+					{
+						key = "blocked-ips"
+						name = "Blocked IPs"
+						type = "ips"
+						data = jsonencode(` + ipsList + `)
+					},
+					{
+						key = "same-key"
+						name = "Texts List"
+						type = "texts"
+						data = jsonencode(` + textsList + `)
+					},
+					{
+						key = "same-key"
+						name = "JSON List"
+						type = "json"
+						data = jsonencode(` + jsonList + `)
+					}
+				]
+			`),
+			ExpectError: regexp.MustCompile(`Keys must be unique`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				lists = [
+					{
+						key = "blocked-ips"
+						name = "Blocked IPs"
+						type = "ips"
+						data = jsonencode(` + ipsList + `)
+					}
+				]
+			`),
+			Check: p.Check(map[string]any{
+				"lists.#":     1,
+				"lists.0.key": "blocked-ips",
+			}),
+			ConfigStateChecks: []statecheck.StateCheck{
+				listID.AddStateValue(p.Path(), tfjsonpath.New("lists").AtSliceIndex(0).AtMapKey("id")),
+			},
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				lists = [
+					{
+						key = "allowed-texts"
+						name = "Allowed Texts"
+						type = "texts"
+						data = jsonencode(` + textsList + `)
+					},
+					{
+						key = "blocked-ips"
+						name = "Denied IPs"
+						type = "ips"
+						data = jsonencode(` + ipsList + `)
+					}
+				]
+			`),
+			Check: p.Check(map[string]any{
+				"lists.#":      2,
+				"lists.1.name": "Denied IPs",
+			}),
+			ConfigStateChecks: []statecheck.StateCheck{
+				listID.AddStateValue(p.Path(), tfjsonpath.New("lists").AtSliceIndex(1).AtMapKey("id")),
+			},
+		},
 		resource.TestStep{
 			Config: p.Config(`
 				lists = []
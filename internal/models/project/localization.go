@@ -0,0 +1,79 @@
+package project
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/mapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/localization"
+)
+
+// Checks that the translations in each locale refer to texts that exist in the screens of the
+// project's flows, and reports any texts in the translated flows that are missing translations.
+func (m *ProjectModel) validateLocalization(h *helpers.Handler) {
+	l, _ := m.Localization.ToObject(h.Ctx)
+	if l == nil || helpers.HasUnknownValues(l.Locales, m.Flows) {
+		return
+	}
+
+	locales := map[string]localization.Translations{}
+	for locale, v := range mapattr.Iterator(l.Locales, h) {
+		if v.Data.IsUnknown() {
+			return
+		}
+		translations, err := v.Translations()
+		if err != nil {
+			return // reported by the localization validator
+		}
+		locales[locale] = translations
+	}
+
+	flows := map[string]map[string][]string{}
+	for flowID, flow := range mapattr.Iterator(m.Flows, h) {
+		flows[flowID] = flow.ScreenTexts()
+	}
+
+	translated := map[string]bool{}
+	for _, locale := range slices.Sorted(maps.Keys(locales)) {
+		for _, flowID := range slices.Sorted(maps.Keys(locales[locale])) {
+			screens, ok := flows[flowID]
+			if !ok {
+				h.Warn("Unknown Localized Flow", "The '%s' locale has translations for the '%s' flow which isn't defined in the project's flows so they can't be validated", locale, flowID)
+				continue
+			} else if screens == nil {
+				continue // flow data isn't known yet
+			}
+			translated[flowID] = true
+			for _, screenID := range slices.Sorted(maps.Keys(locales[locale][flowID])) {
+				texts, ok := screens[screenID]
+				if !ok {
+					h.Error("Unknown Localized Screen", "The '%s' locale has translations for the '%s' screen which doesn't exist in the '%s' flow", locale, screenID, flowID)
+					continue
+				}
+				for _, key := range slices.Sorted(maps.Keys(locales[locale][flowID][screenID])) {
+					if !slices.Contains(texts, key) {
+						h.Error("Unknown Localized Text", "The '%s' locale has a translation for the '%s' text which doesn't exist in the '%s' screen of the '%s' flow", locale, key, screenID, flowID)
+					}
+				}
+			}
+		}
+	}
+
+	for _, locale := range slices.Sorted(maps.Keys(locales)) {
+		for _, flowID := range slices.Sorted(maps.Keys(translated)) {
+			missing := []string{}
+			for _, screenID := range slices.Sorted(maps.Keys(flows[flowID])) {
+				for _, key := range flows[flowID][screenID] {
+					if _, ok := locales[locale][flowID][screenID][key]; !ok {
+						missing = append(missing, screenID+"."+key)
+					}
+				}
+			}
+			if len(missing) > 0 {
+				h.Warn("Untranslated Flow Texts", "The '%s' locale is missing translations for %d texts in the '%s' flow: %s", locale, len(missing), flowID, strings.Join(missing, ", "))
+			}
+		}
+	}
+}
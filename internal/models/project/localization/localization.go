@@ -0,0 +1,130 @@
+package localization
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/mapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var LocalizationValidator = objattr.NewValidator[LocalizationModel]("must have valid locales and translations")
+
+var LocaleIDValidator = mapvalidator.KeysAre(stringattr.LocaleValidator)
+
+var LocalizationAttributes = map[string]schema.Attribute{
+	"default_locale": stringattr.Default("en", stringattr.LocaleValidator),
+	"locales":        mapattr.Default[LocaleModel](nil, LocaleAttributes, LocaleIDValidator),
+}
+
+// The localization settings are read from and written to the "localization" section of the
+// project snapshot, with the default locale in "defaultLocale" and the translations of each
+// locale in "locales".
+type LocalizationModel struct {
+	DefaultLocale stringattr.Type           `tfsdk:"default_locale"`
+	Locales       mapattr.Type[LocaleModel] `tfsdk:"locales"`
+}
+
+func (m *LocalizationModel) Values(h *helpers.Handler) map[string]any {
+	data := map[string]any{}
+	stringattr.Get(m.DefaultLocale, data, "defaultLocale")
+	mapattr.Get(m.Locales, data, "locales", h)
+	return data
+}
+
+func (m *LocalizationModel) SetValues(h *helpers.Handler, data map[string]any) {
+	stringattr.Set(&m.DefaultLocale, data, "defaultLocale")
+	mapattr.Set(&m.Locales, data, "locales", h)
+}
+
+func (m *LocalizationModel) Validate(h *helpers.Handler) {
+	if helpers.HasUnknownValues(m.DefaultLocale, m.Locales) {
+		return
+	}
+	if _, ok := m.Locales.Elements()[m.DefaultLocale.ValueString()]; ok {
+		h.Error("Invalid Locale", "The default locale '%s' must not have translations as the flows are already written in it", m.DefaultLocale.ValueString())
+	}
+	for locale, l := range mapattr.Iterator(m.Locales, h) {
+		if l.Data.IsUnknown() {
+			continue
+		}
+		if _, err := l.Translations(); err != nil {
+			h.Error("Invalid Translations", "The translations for the '%s' locale are invalid: %s", locale, err.Error())
+		}
+	}
+}
+
+// Locale
+
+var LocaleAttributes = map[string]schema.Attribute{
+	"data": stringattr.Required(stringattr.JSONValidator()),
+}
+
+type LocaleModel struct {
+	Data stringattr.Type `tfsdk:"data"`
+}
+
+func (m *LocaleModel) Values(h *helpers.Handler) map[string]any {
+	data := map[string]any{}
+	if err := json.Unmarshal([]byte(m.Data.ValueString()), &data); err != nil {
+		h.Error("Invalid translations data", "Failed to parse JSON: %s", err.Error())
+		return nil
+	}
+	return data
+}
+
+func (m *LocaleModel) SetValues(h *helpers.Handler, data map[string]any) {
+	if m.Data.ValueString() != "" {
+		return // We do not update the translations data if it's already set to preserve the formatting
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		h.Error("Unexpected translations data", "Failed to parse JSON: %s", err.Error())
+		return
+	}
+	m.Data = stringattr.Value(string(b))
+}
+
+// The translated texts of a locale, keyed by flow id, screen id and text key.
+type Translations map[string]map[string]map[string]string
+
+// Returns the translated texts in the locale data, which is expected to be a JSON object with
+// the flow ids as keys, and for each flow an object with the screen ids as keys, and for each
+// screen an object with the text keys as keys and the translated texts as values.
+func (m *LocaleModel) Translations() (Translations, error) {
+	data := map[string]any{}
+	if err := json.Unmarshal([]byte(m.Data.ValueString()), &data); err != nil {
+		return nil, err
+	}
+
+	result := Translations{}
+	for _, flowID := range slices.Sorted(maps.Keys(data)) {
+		screens, ok := data[flowID].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("the value for the '%s' flow must be an object with screen ids as keys", flowID)
+		}
+		result[flowID] = map[string]map[string]string{}
+		for _, screenID := range slices.Sorted(maps.Keys(screens)) {
+			texts, ok := screens[screenID].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("the value for the '%s' screen in the '%s' flow must be an object with text keys as keys", screenID, flowID)
+			}
+			result[flowID][screenID] = map[string]string{}
+			for _, key := range slices.Sorted(maps.Keys(texts)) {
+				text, ok := texts[key].(string)
+				if !ok {
+					return nil, fmt.Errorf("the translation for the '%s' text in the '%s' screen of the '%s' flow must be a string", key, screenID, flowID)
+				}
+				result[flowID][screenID][key] = text
+			}
+		}
+	}
+	return result, nil
+}
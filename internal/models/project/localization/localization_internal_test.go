@@ -0,0 +1,56 @@
+package localization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/mapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslations(t *testing.T) {
+	m := &LocaleModel{Data: stringattr.Value(`{"sign-in": {"welcome": {"heading.text": "Willkommen", "submit.text": "Weiter"}}}`)}
+	translations, err := m.Translations()
+	require.NoError(t, err)
+	assert.Equal(t, Translations{"sign-in": {"welcome": {"heading.text": "Willkommen", "submit.text": "Weiter"}}}, translations)
+
+	m.Data = stringattr.Value(`{"sign-in": ["welcome"]}`)
+	_, err = m.Translations()
+	assert.EqualError(t, err, "the value for the 'sign-in' flow must be an object with screen ids as keys")
+
+	m.Data = stringattr.Value(`{"sign-in": {"welcome": "Willkommen"}}`)
+	_, err = m.Translations()
+	assert.EqualError(t, err, "the value for the 'welcome' screen in the 'sign-in' flow must be an object with text keys as keys")
+
+	m.Data = stringattr.Value(`{"sign-in": {"welcome": {"heading.text": {"de": "Willkommen"}}}}`)
+	_, err = m.Translations()
+	assert.EqualError(t, err, "the translation for the 'heading.text' text in the 'welcome' screen of the 'sign-in' flow must be a string")
+}
+
+func TestSetValues(t *testing.T) {
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	// locales that were added or removed outside of terraform show up as drift
+	m := &LocalizationModel{
+		DefaultLocale: stringattr.Value("en"),
+		Locales:       mapattr.Value(map[string]*LocaleModel{"de": {Data: stringattr.Value(`{}`)}}),
+	}
+	m.SetValues(h, map[string]any{
+		"defaultLocale": "en",
+		"locales": map[string]any{
+			"fr": map[string]any{"sign-in": map[string]any{"welcome": map[string]any{"heading.text": "Bienvenue"}}},
+		},
+	})
+	require.False(t, diags.HasError())
+
+	locales := map[string]string{}
+	for locale, l := range mapattr.Iterator(m.Locales, h) {
+		locales[locale] = l.Data.ValueString()
+	}
+	assert.Equal(t, map[string]string{"fr": `{"sign-in":{"welcome":{"heading.text":"Bienvenue"}}}`}, locales)
+}
@@ -0,0 +1,78 @@
+package localization_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/tools/testacc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLocalization(t *testing.T) {
+	p := testacc.Project(t)
+	testacc.Run(t,
+		resource.TestStep{
+			Config: p.Config(`
+				localization = {
+					locales = {
+						"de_de" = {
+							data = jsonencode({})
+						}
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`should be written as 'de-DE'`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				localization = {
+					default_locale = "de"
+					locales = {
+						"de" = {
+							data = jsonencode({})
+						}
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`default locale 'de' must not have translations`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				localization = {
+					locales = {
+						"de" = {
+							data = jsonencode({
+								"sign-in" = {
+									"welcome" = "Willkommen"
+								}
+							})
+						}
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`Invalid Translations`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				localization = {
+					locales = {
+						"de" = {
+							data = jsonencode({
+								"sign-in" = {
+									"welcome" = {
+										"heading.text" = "Willkommen"
+									}
+								}
+							})
+						}
+					}
+				}
+			`),
+			Check: p.Check(map[string]any{
+				"localization.default_locale":  "en",
+				"localization.locales.%":       1,
+				"localization.locales.de.data": testacc.AttributeIsSet,
+			}),
+		},
+	)
+}
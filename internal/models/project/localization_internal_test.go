@@ -0,0 +1,76 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/mapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/flows"
+	"github.com/descope/terraform-provider-descope/internal/models/project/localization"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateLocalization(t *testing.T) {
+	validate := func(locales map[string]string) (errors []string, warnings []string) {
+		models := map[string]*localization.LocaleModel{}
+		for locale, data := range locales {
+			models[locale] = &localization.LocaleModel{Data: stringattr.Value(data)}
+		}
+		m := &ProjectModel{
+			Flows: mapattr.Value(map[string]*flows.FlowModel{
+				"sign-in": {Data: stringattr.Value(`{"screens": [{"id": "welcome", "htmlTemplate": {"children": [{"id": "heading", "text": "Welcome"}, {"id": "submit", "text": "Continue"}]}}]}`)},
+			}),
+			Localization: objattr.Value(&localization.LocalizationModel{
+				DefaultLocale: stringattr.Value("en"),
+				Locales:       mapattr.Value(models),
+			}),
+		}
+		diags := diag.Diagnostics{}
+		m.validateLocalization(helpers.NewHandler(context.Background(), &diags))
+		for _, d := range diags {
+			if d.Severity() == diag.SeverityError {
+				errors = append(errors, d.Detail())
+			} else {
+				warnings = append(warnings, d.Detail())
+			}
+		}
+		return
+	}
+
+	errors, warnings := validate(map[string]string{
+		"de": `{"sign-in": {"welcome": {"heading.text": "Willkommen", "submit.text": "Weiter"}}}`,
+		"fr": `{"sign-in": {"welcome": {"heading.text": "Bienvenue", "submit.text": "Continuer"}}}`,
+	})
+	assert.Empty(t, errors)
+	assert.Empty(t, warnings)
+
+	errors, warnings = validate(map[string]string{
+		"de": `{"sign-in": {"welcome": {"heading.text": "Willkommen", "title.text": "Titel"}, "goodbye": {}}, "sign-up": {}}`,
+		"fr": `{}`,
+	})
+	require.Len(t, errors, 2)
+	assert.Equal(t, "The 'de' locale has translations for the 'goodbye' screen which doesn't exist in the 'sign-in' flow", errors[0])
+	assert.Equal(t, "The 'de' locale has a translation for the 'title.text' text which doesn't exist in the 'welcome' screen of the 'sign-in' flow", errors[1])
+	require.Len(t, warnings, 3)
+	assert.Equal(t, "The 'de' locale has translations for the 'sign-up' flow which isn't defined in the project's flows so they can't be validated", warnings[0])
+	assert.Equal(t, "The 'de' locale is missing translations for 1 texts in the 'sign-in' flow: welcome.submit.text", warnings[1])
+	assert.Equal(t, "The 'fr' locale is missing translations for 2 texts in the 'sign-in' flow: welcome.heading.text, welcome.submit.text", warnings[2])
+}
+
+func TestLocaleValues(t *testing.T) {
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	m := &localization.LocaleModel{Data: stringattr.Value(`{"sign-in": {"welcome": {"heading.text": "Willkommen"}}}`)}
+	assert.Equal(t, map[string]any{"sign-in": map[string]any{"welcome": map[string]any{"heading.text": "Willkommen"}}}, m.Values(h))
+	assert.False(t, diags.HasError())
+
+	m = &localization.LocaleModel{Data: stringattr.Value(`{"sign-in":`)}
+	require.NotPanics(t, func() { m.Values(h) })
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,45 @@
+package project
+
+import (
+	"slices"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// The built-in user attributes that values from the vendor's user can be mapped to.
+var userMappingBuiltinKeys = []string{"loginId", "name", "givenName", "middleName", "familyName", "email", "phone", "verifiedEmail", "verifiedPhone", "picture"}
+
+// Checks that the user_mapping of the session migration only maps values to built-in user
+// attributes or to custom user attributes that are defined in the project's attributes. The
+// custom attributes are only checked when the user attributes are set in the configuration,
+// as otherwise they're not managed by the project resource.
+func (m *ProjectModel) validateSessionMigrationMapping(h *helpers.Handler) {
+	s, _ := m.Settings.ToObject(h.Ctx)
+	if s == nil || s.SessionMigration.IsUnknown() || m.Attributes.IsNull() || m.Attributes.IsUnknown() {
+		return
+	}
+	migration, _ := s.SessionMigration.ToObject(h.Ctx)
+	if migration == nil || migration.UserMapping.IsUnknown() {
+		return
+	}
+
+	attrs, _ := m.Attributes.ToObject(h.Ctx)
+	if attrs == nil || attrs.User.IsNull() || attrs.User.IsUnknown() {
+		return
+	}
+	defined := attributeIDs(h, attrs.User)
+	if defined == nil {
+		return
+	}
+
+	for item := range listattr.Iterator(migration.UserMapping, h) {
+		if item.DescopeKey.IsUnknown() {
+			continue
+		}
+		key := item.DescopeKey.ValueString()
+		if !slices.Contains(userMappingBuiltinKeys, key) && !defined[key] {
+			h.Error("Unknown User Mapping Attribute", "The session migration maps the external key '%s' to the descope_key '%s' but it's neither a built-in user attribute nor a custom user attribute with that id defined in the project's attributes", item.ExternalKey.ValueString(), key)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/attributes"
+	"github.com/descope/terraform-provider-descope/internal/models/project/settings"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSessionMigrationMapping(t *testing.T) {
+	validate := func(userAttributes []string, descopeKeys ...string) (errors []string) {
+		mapping := []*settings.ExternalAuthUserMappingItemModel{}
+		for _, key := range descopeKeys {
+			mapping = append(mapping, &settings.ExternalAuthUserMappingItemModel{ExternalKey: stringattr.Value("ext"), DescopeKey: stringattr.Value(key)})
+		}
+		m := &ProjectModel{
+			Settings: objattr.Value(&settings.SettingsModel{
+				SessionMigration: objattr.Value(&settings.SessionMigrationModel{
+					UserMapping: listattr.Value(mapping),
+				}),
+			}),
+		}
+		if userAttributes != nil {
+			user := []*attributes.UserAttributeModel{}
+			for _, id := range userAttributes {
+				user = append(user, &attributes.UserAttributeModel{AttributeModel: attributes.AttributeModel{ID: stringattr.Value(id)}})
+			}
+			m.Attributes = objattr.Value(&attributes.AttributesModel{User: listattr.Value(user)})
+		}
+		diags := diag.Diagnostics{}
+		m.validateSessionMigrationMapping(helpers.NewHandler(context.Background(), &diags))
+		for _, d := range diags.Errors() {
+			errors = append(errors, d.Detail())
+		}
+		return
+	}
+
+	assert.Empty(t, validate(nil, "email", "givenName"))
+	assert.Empty(t, validate([]string{"department"}, "email", "department"))
+
+	// the custom attributes aren't checked when they're not in the configuration
+	assert.Empty(t, validate(nil, "email", "department"))
+
+	errors := validate([]string{}, "email", "department")
+	require.Len(t, errors, 1)
+	assert.Equal(t, "The session migration maps the external key 'ext' to the descope_key 'department' but it's neither a built-in user attribute nor a custom user attribute with that id defined in the project's attributes", errors[0])
+
+	errors = validate([]string{"department"}, "Email", "team")
+	require.Len(t, errors, 2)
+	assert.Contains(t, errors[0], "descope_key 'Email'")
+	assert.Contains(t, errors[1], "descope_key 'team'")
+}
@@ -0,0 +1,68 @@
+package project
+
+import (
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
+)
+
+// Checks a production project for settings that are risky or that are usually only meant for
+// development and testing, and reports them according to the policy in the provider configuration.
+// Values that are unknown at plan time are skipped.
+func (m *ProjectModel) CheckPolicy(h *helpers.Handler, p *policy.Policy) {
+	if m.Environment.IsUnknown() || m.Environment.ValueString() != "production" {
+		return
+	}
+
+	if settings, _ := m.Settings.ToObject(h.Ctx); settings != nil {
+		if isSet(settings.TestUsersStaticOTP) || isSet(settings.TestUsersLoginIDRegExp) {
+			p.Report(h, policy.CheckTestUsers, "Test users are enabled by the test_users_static_otp or test_users_loginid_regexp attributes in project_settings")
+		}
+		if isTrue(settings.AllowAuthHostingIframeEmbedding) {
+			p.Report(h, policy.CheckIframeEmbedding, "The allow_auth_hosting_iframe_embedding attribute in project_settings allows the hosted authentication pages to be embedded in other websites")
+		}
+		if isFalse(settings.RefreshTokenRotation) {
+			p.Report(h, policy.CheckRefreshTokenRotation, "The refresh_token_rotation attribute in project_settings is disabled, so a leaked refresh token can be used until it expires")
+		}
+		checkCookiePolicy(h, p, "refresh_token", settings.RefreshTokenResponseMethod, settings.RefreshTokenCookiePolicy, settings.RefreshTokenCookieDomain)
+		checkCookiePolicy(h, p, "session_token", settings.SessionTokenResponseMethod, settings.SessionTokenCookiePolicy, settings.SessionTokenCookieDomain)
+	}
+
+	if connectors, _ := m.Connectors.ToObject(h.Ctx); connectors != nil {
+		if names := connectors.InsecureConnectorNames(h); len(names) > 0 {
+			p.Report(h, policy.CheckInsecureConnectors, "The insecure attribute is enabled in the connectors: %s", strings.Join(names, ", "))
+		}
+	}
+
+	if authentication, _ := m.Authentication.ToObject(h.Ctx); authentication != nil {
+		if password, _ := authentication.Password.ToObject(h.Ctx); password != nil && isFalse(password.Disabled) {
+			if v := password.EnforceStrength; !v.IsUnknown() && v.ValueString() == "none" {
+				p.Report(h, policy.CheckPasswordStrength, "The enforce_strength attribute in the password authentication method is set to 'none'")
+			}
+		}
+	}
+}
+
+func checkCookiePolicy(h *helpers.Handler, p *policy.Policy, token string, method, sameSite, domain stringattr.Type) {
+	if helpers.HasUnknownValues(method, sameSite, domain) || method.ValueString() != "cookies" {
+		return
+	}
+	if sameSite.ValueString() == "none" && domain.ValueString() == "" {
+		p.Report(h, policy.CheckCookiePolicy, "The %s_cookie_policy attribute in project_settings is set to 'none' without a %s_cookie_domain", token, token)
+	}
+}
+
+func isSet(v stringattr.Type) bool {
+	return !v.IsUnknown() && v.ValueString() != ""
+}
+
+func isTrue(v boolattr.Type) bool {
+	return !v.IsUnknown() && !v.IsNull() && v.ValueBool()
+}
+
+func isFalse(v boolattr.Type) bool {
+	return !v.IsUnknown() && !v.IsNull() && !v.ValueBool()
+}
@@ -0,0 +1,55 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
+	"github.com/descope/terraform-provider-descope/internal/models/project/settings"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	m := &ProjectModel{
+		Environment: stringattr.Value("production"),
+		Settings: objattr.Value(&settings.SettingsModel{
+			TestUsersStaticOTP:              stringattr.Value("123456"),
+			AllowAuthHostingIframeEmbedding: boolattr.Value(true),
+			RefreshTokenRotation:            boolattr.Value(true),
+			RefreshTokenResponseMethod:      stringattr.Value("cookies"),
+			RefreshTokenCookiePolicy:        stringattr.Value("none"),
+			RefreshTokenCookieDomain:        stringattr.Value(""),
+			SessionTokenResponseMethod:      stringattr.Value("response_body"),
+			SessionTokenCookiePolicy:        stringattr.Value("none"),
+		}),
+	}
+
+	// failed checks are reported as warnings by default
+	diags := checkPolicy(m, nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 3, diags.WarningsCount())
+	assert.Contains(t, diags[0].Detail(), "[test_users]")
+	assert.Contains(t, diags[1].Detail(), "[iframe_embedding]")
+	assert.Contains(t, diags[2].Detail(), "refresh_token_cookie_policy")
+
+	// failed checks are reported as errors and can be skipped
+	diags = checkPolicy(m, &policy.Policy{Enforcement: policy.EnforcementError, SkipChecks: []string{policy.CheckTestUsers}})
+	assert.Equal(t, 2, diags.ErrorsCount())
+	assert.Equal(t, 0, diags.WarningsCount())
+
+	// nothing is reported when the checks are disabled or the project isn't in production
+	assert.Empty(t, checkPolicy(m, &policy.Policy{Enforcement: policy.EnforcementDisabled}))
+	m.Environment = stringattr.Value("")
+	assert.Empty(t, checkPolicy(m, nil))
+}
+
+func checkPolicy(m *ProjectModel, p *policy.Policy) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	m.CheckPolicy(helpers.NewHandler(context.Background(), &diags), p)
+	return diags
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/project/flows"
 	"github.com/descope/terraform-provider-descope/internal/models/project/jwttemplates"
 	"github.com/descope/terraform-provider-descope/internal/models/project/lists"
+	"github.com/descope/terraform-provider-descope/internal/models/project/localization"
 	"github.com/descope/terraform-provider-descope/internal/models/project/settings"
 	"github.com/descope/terraform-provider-descope/internal/models/project/widgets"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -28,10 +29,10 @@ var ProjectAttributes = map[string]schema.Attribute{
 	"environment":      stringattr.Optional(stringvalidator.OneOf("", "production")),
 	"tags":             strsetattr.Optional(stringvalidator.LengthBetween(1, 50)),
 	"project_settings": objattr.Optional[settings.SettingsModel](settings.SettingsAttributes, settings.SettingsValidator),
-	"invite_settings":  objattr.Default(settings.InviteSettingsDefault, settings.InviteSettingsAttributes),
+	"invite_settings":  objattr.Default(settings.InviteSettingsDefault, settings.InviteSettingsAttributes, settings.InviteSettingsValidator),
 	"authentication":   objattr.Default[authentication.AuthenticationModel](nil, authentication.AuthenticationAttributes),
 	"authorization":    objattr.Default[authorization.AuthorizationModel](nil, authorization.AuthorizationAttributes, authorization.AuthorizationModifier, authorization.AuthorizationValidator),
-	"attributes":       objattr.Default[attributes.AttributesModel](nil, attributes.AttributesAttributes),
+	"attributes":       objattr.Default[attributes.AttributesModel](nil, attributes.AttributesAttributes, attributes.AttributesModifier, attributes.AttributesValidator),
 	"connectors":       objattr.Default[connectors.ConnectorsModel](nil, connectors.ConnectorsAttributes, connectors.ConnectorsModifier, connectors.ConnectorsValidator),
 	"applications":     objattr.Default[applications.ApplicationsModel](nil, applications.ApplicationsAttributes, applications.ApplicationsModifier, applications.ApplicationsValidator),
 	"jwt_templates":    objattr.Optional[jwttemplates.JWTTemplatesModel](jwttemplates.JWTTemplatesAttributes, jwttemplates.JWTTemplatesValidator),
@@ -40,6 +41,7 @@ var ProjectAttributes = map[string]schema.Attribute{
 	"widgets":          mapattr.Optional[widgets.WidgetModel](widgets.WidgetAttributes, widgets.WidgetIDValidator),
 	"lists":            listattr.Default[lists.ListModel](lists.ListAttributes, lists.ListValidator, lists.ListsModifier),
 	"admin_portal":     objattr.Default[adminportal.AdminPortalModel](nil, adminportal.AdminPortalAttributes, adminportal.AdminPortalValidator),
+	"localization":     objattr.Optional[localization.LocalizationModel](localization.LocalizationAttributes, localization.LocalizationValidator),
 }
 
 type ProjectModel struct {
@@ -60,6 +62,7 @@ type ProjectModel struct {
 	Widgets        mapattr.Type[widgets.WidgetModel]                `tfsdk:"widgets"`
 	Lists          listattr.Type[lists.ListModel]                   `tfsdk:"lists"`
 	AdminPortal    objattr.Type[adminportal.AdminPortalModel]       `tfsdk:"admin_portal"`
+	Localization   objattr.Type[localization.LocalizationModel]     `tfsdk:"localization"`
 }
 
 func (m *ProjectModel) Values(h *helpers.Handler) map[string]any {
@@ -83,6 +86,7 @@ func (m *ProjectModel) Values(h *helpers.Handler) map[string]any {
 	widgets.EnsureWidgetIDs(m.Widgets, data, "widgets", h)
 	listattr.Get(m.Lists, data, "lists", h)
 	objattr.Get(m.AdminPortal, data, "adminportal", h)
+	objattr.Get(m.Localization, data, "localization", h)
 	return data
 }
 
@@ -115,6 +119,14 @@ func (m *ProjectModel) SetValues(h *helpers.Handler, data map[string]any) {
 	}
 	listattr.SetMatchingNames(&m.Lists, data, "lists", "name", h)
 	objattr.Set(&m.AdminPortal, data, "adminportal", h)
+	objattr.Set(&m.Localization, data, "localization", h)
+}
+
+func (m *ProjectModel) Validate(h *helpers.Handler) {
+	m.validateRedirectURLs(h)
+	m.validateJWTTemplateAttributes(h)
+	m.validateLocalization(h)
+	m.validateSessionMigrationMapping(h)
 }
 
 func (m *ProjectModel) CollectReferences(h *helpers.Handler) {
@@ -42,6 +42,20 @@ func TestProject(t *testing.T) {
 				"environment": "production",
 			}),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				environment = "production"
+				project_settings = {
+					approved_domains = ["example.com"]
+				}
+				authentication = {
+					magic_link = {
+						redirect_url = "https://app.exmaple.com/verify"
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`Unapproved Redirect URL`),
+		},
 		resource.TestStep{
 			Config: p.Config(`
 				environment = ""
@@ -0,0 +1,139 @@
+package project
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/strsetattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+)
+
+// Checks that the redirect URLs across all sections of the project configuration point to hosts
+// that are in the project's approved domains or its custom domain. This is reported as a warning
+// for most projects and as an error for production projects, as a typo in a redirect host will
+// usually break authentication for all users.
+func (m *ProjectModel) validateRedirectURLs(h *helpers.Handler) {
+	settings, _ := m.Settings.ToObject(h.Ctx)
+	if settings == nil || helpers.HasUnknownValues(m.Environment, settings.ApprovedDomain, settings.CustomDomain) {
+		return
+	}
+
+	domains := []string{}
+	for domain := range strsetattr.Iterator(settings.ApprovedDomain, h) {
+		domains = append(domains, domain)
+	}
+	if v := settings.CustomDomain.ValueString(); v != "" {
+		domains = append(domains, v)
+	}
+	if len(domains) == 0 {
+		return // nothing to validate against if there are no trusted domains
+	}
+
+	checker := &RedirectURLChecker{Domains: domains, Production: m.Environment.ValueString() == "production"}
+
+	checker.Check(h, settings.AppURL, "project_settings.app_url")
+
+	if invite, _ := m.Invite.ToObject(h.Ctx); invite != nil {
+		checker.Check(h, invite.InviteURL, "invite_settings.invite_url")
+	}
+
+	if authentication, _ := m.Authentication.ToObject(h.Ctx); authentication != nil {
+		if magicLink, _ := authentication.MagicLink.ToObject(h.Ctx); magicLink != nil {
+			checker.Check(h, magicLink.RedirectURL, "authentication.magic_link.redirect_url")
+		}
+		if sso, _ := authentication.SSO.ToObject(h.Ctx); sso != nil {
+			checker.Check(h, sso.RedirectURL, "authentication.sso.redirect_url")
+		}
+	}
+
+	if applications, _ := m.Applications.ToObject(h.Ctx); applications != nil {
+		for app := range listattr.Iterator(applications.OIDCApplications, h) {
+			if !app.ApprovedRedirectURLs.IsUnknown() {
+				for v := range strsetattr.Iterator(app.ApprovedRedirectURLs, h) {
+					checker.Check(h, stringattr.Value(v), "approved_redirect_urls of the '"+app.Name.ValueString()+"' OIDC application")
+				}
+			}
+		}
+		for app := range listattr.Iterator(applications.WSFedApplications, h) {
+			checker.Check(h, app.ReplyURL, "reply_url of the '"+app.Name.ValueString()+"' WS-Fed application")
+			checker.Check(h, app.LogoutRedirectURL, "logout_redirect_url of the '"+app.Name.ValueString()+"' WS-Fed application")
+		}
+	}
+}
+
+// Checks redirect URLs against the approved domains and custom domain of a project, reporting
+// unapproved URLs as warnings or as errors in production projects.
+type RedirectURLChecker struct {
+	Domains    []string
+	Production bool
+}
+
+// Returns a checker for the redirect URLs of resources that belong to a project, such as inbound
+// apps, using the project's configuration as returned by the backend, or nil if the project
+// doesn't have any trusted domains to check against. Unapproved URLs are only reported as
+// warnings, even for production projects, as the project's domains might be changed in the
+// same plan and the configuration returned by the backend would be stale.
+func NewRedirectURLChecker(data map[string]any) *RedirectURLChecker {
+	settings, _ := data["settings"].(map[string]any)
+	domains := []string{}
+	if v, _ := settings["trustedDomains"].(string); v != "" {
+		for domain := range strings.SplitSeq(v, ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				domains = append(domains, domain)
+			}
+		}
+	}
+	if v, _ := settings["customDomain"].(string); v != "" {
+		domains = append(domains, v)
+	}
+	if len(domains) == 0 {
+		return nil
+	}
+	return &RedirectURLChecker{Domains: domains}
+}
+
+func (c *RedirectURLChecker) Check(h *helpers.Handler, value stringattr.Type, attribute string) {
+	if value.IsUnknown() || value.ValueString() == "" {
+		return
+	}
+	host := redirectURLHost(value.ValueString())
+	if host == "" || isApprovedHost(host, c.Domains) {
+		return
+	}
+	summary := "Unapproved Redirect URL"
+	format := "The %s attribute is set to the URL '%s' whose host '%s' is not in the project's approved_domains or its custom_domain"
+	if c.Production {
+		h.Error(summary, format, attribute, value.ValueString(), host)
+	} else {
+		h.Warn(summary, format, attribute, value.ValueString(), host)
+	}
+}
+
+// Returns the lowercase host of an absolute URL, or an empty string if the value isn't one,
+// e.g., for relative paths or values that use template placeholders.
+func redirectURLHost(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// Returns whether the host matches or is a subdomain of any of the approved domains.
+func isApprovedHost(host string, domains []string) bool {
+	for _, domain := range domains {
+		if v := redirectURLHost(domain); v != "" {
+			domain = v // allow approved domains that were set as URLs
+		}
+		domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "*."))
+		if domain == "" {
+			continue
+		}
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovedHost(t *testing.T) {
+	assert.Equal(t, "app.example.com", redirectURLHost("https://App.Example.com:8443/verify"))
+	assert.Equal(t, "", redirectURLHost("/verify"))
+	assert.Equal(t, "", redirectURLHost("{{url}}/verify"))
+
+	domains := []string{"example.com", "*.example.org", "https://login.example.net"}
+	assert.True(t, isApprovedHost("example.com", domains))
+	assert.True(t, isApprovedHost("app.example.com", domains))
+	assert.True(t, isApprovedHost("app.example.org", domains))
+	assert.True(t, isApprovedHost("login.example.net", domains))
+	assert.False(t, isApprovedHost("example.net", domains))
+	assert.False(t, isApprovedHost("notexample.com", domains))
+	assert.False(t, isApprovedHost("app.exmaple.com", domains))
+}
+
+func TestRedirectURLChecker(t *testing.T) {
+	assert.Nil(t, NewRedirectURLChecker(map[string]any{}))
+	assert.Nil(t, NewRedirectURLChecker(map[string]any{"settings": map[string]any{"trustedDomains": ""}}))
+
+	checker := NewRedirectURLChecker(map[string]any{
+		"environment": "production",
+		"settings":    map[string]any{"trustedDomains": "example.com, *.example.org", "customDomain": "auth.example.net"},
+	})
+	require.NotNil(t, checker)
+	assert.Equal(t, []string{"example.com", "*.example.org", "auth.example.net"}, checker.Domains)
+
+	// the project read from the backend might be stale so production projects only get warnings
+	assert.False(t, checker.Production)
+
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+	checker.Check(h, stringattr.Value("https://app.example.org/callback"), "approved_callback_urls")
+	checker.Check(h, stringattr.Value("https://auth.example.net/callback"), "approved_callback_urls")
+	require.Empty(t, diags)
+
+	checker.Check(h, stringattr.Value("https://app.exmaple.com/callback"), "approved_callback_urls")
+	assert.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "The approved_callback_urls attribute is set to the URL 'https://app.exmaple.com/callback' whose host 'app.exmaple.com' is not in the project's approved_domains or its custom_domain", diags.Warnings()[0].Detail())
+
+	diags = diag.Diagnostics{}
+	checker.Production = true
+	checker.Check(helpers.NewHandler(context.Background(), &diags), stringattr.Value("https://app.exmaple.com/callback"), "approved_callback_urls")
+	assert.True(t, diags.HasError())
+}
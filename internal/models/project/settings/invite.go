@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
+var InviteSettingsValidator = objattr.NewValidator[InviteSettingsModel]("must have valid message templates")
+
 var InviteSettingsAttributes = map[string]schema.Attribute{
 	"require_invitation":   boolattr.Default(false),
 	"invite_url":           stringattr.Default(""),
@@ -69,6 +71,10 @@ func (m *InviteSettingsModel) SetValues(h *helpers.Handler, data map[string]any)
 	objattr.Set(&m.EmailService, data, helpers.RootKey, h)
 }
 
+func (m *InviteSettingsModel) Validate(h *helpers.Handler) {
+	templates.ValidateEmailService(h, m.EmailService, templates.InviteMessages)
+}
+
 func (m *InviteSettingsModel) UpdateReferences(h *helpers.Handler) {
 	objattr.UpdateReferences(&m.EmailService, h)
 }
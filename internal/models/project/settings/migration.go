@@ -1,6 +1,9 @@
 package settings
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
@@ -12,8 +15,10 @@ import (
 
 var SessionMigrationValidator = objattr.NewValidator[SessionMigrationModel]("must have a valid configuration")
 
+var sessionMigrationVendorNames = slices.Sorted(maps.Keys(sessionMigrationVendors))
+
 var SessionMigrationAttributes = map[string]schema.Attribute{
-	"vendor":                     stringattr.Default("", stringattr.StandardLenValidator),
+	"vendor":                     stringattr.Default("", stringvalidator.OneOf(append([]string{""}, sessionMigrationVendorNames...)...)),
 	"client_id":                  stringattr.Default("", stringattr.StandardLenValidator),
 	"domain":                     stringattr.Default("", stringattr.StandardLenValidator),
 	"audience":                   stringattr.Default("", stringattr.StandardLenValidator),
@@ -124,44 +129,76 @@ func (m *SessionMigrationModel) Validate(h *helpers.Handler) {
 	}
 
 	vendor := m.Vendor.ValueString()
+	attrs := m.vendorAttributes()
 
-	switch vendor {
-	case "":
-		if m.ClientID.ValueString() != "" || m.Domain.ValueString() != "" || m.Audience.ValueString() != "" || m.Issuer.ValueString() != "" || m.ApiToken.ValueString() != "" || m.UserSyncType.ValueString() != "" || !m.LoginIDMatchedAttributes.IsEmpty() || !m.UserMapping.IsEmpty() {
+	if vendor == "" {
+		set := m.UserSyncType.ValueString() != "" || !m.LoginIDMatchedAttributes.IsEmpty() || !m.UserMapping.IsEmpty()
+		for _, v := range attrs {
+			set = set || v.ValueString() != ""
+		}
+		if set {
 			h.Invalid("The other session_migration attributes must not be set when vendor is not specified")
 		}
 		return
-	case "auth0":
-		if m.Domain.ValueString() == "" {
-			h.Missing("The domain attribute is required for %s session migration", vendor)
-		}
-		if m.Issuer.ValueString() != "" {
-			h.Invalid("The issuer attribute should not be set for %s session migration", vendor)
-		}
-		if m.ApiToken.ValueString() != "" {
-			h.Invalid("The api_token attribute should not be set for %s session migration", vendor)
-		}
-	case "okta":
-		if m.Domain.ValueString() != "" {
-			h.Invalid("The domain attribute should not be set for %s session migration", vendor)
+	}
+
+	v, ok := sessionMigrationVendors[vendor]
+	if !ok {
+		return // reported by the vendor attribute validator
+	}
+	for _, name := range v.Forbidden {
+		if attrs[name].ValueString() != "" {
+			h.Invalid("The %s attribute should not be set for %s session migration", name, vendor)
 		}
-		if m.Issuer.ValueString() == "" {
-			h.Missing("The issuer attribute is required for %s session migration", vendor)
+	}
+	for _, name := range append([]string{"client_id"}, v.Required...) {
+		if attrs[name].ValueString() == "" {
+			h.Missing("The %s attribute is required for %s session migration", name, vendor)
 		}
-		if m.Audience.ValueString() != "" {
-			h.Invalid("The audience attribute should not be set for %s session migration", vendor)
+	}
+	if m.LoginIDMatchedAttributes.IsEmpty() {
+		h.Missing("The loginid_matched_attributes attribute is expected to be a non-empty list for %s session migration", vendor)
+	}
+
+	keys := map[string]bool{}
+	for item := range listattr.Iterator(m.UserMapping, h) {
+		if item.DescopeKey.IsUnknown() {
+			continue
 		}
-		if m.ApiToken.ValueString() == "" {
-			h.Missing("The api_token attribute is required for %s session migration", vendor)
+		key := item.DescopeKey.ValueString()
+		if keys[key] {
+			h.Error("Duplicate User Mapping", "The descope_key '%s' is mapped more than once in the user_mapping of the session migration", key)
 		}
-	default:
-		h.Invalid("Unsupported session migration vendor: %s", vendor)
+		keys[key] = true
 	}
+}
 
-	if m.ClientID.ValueString() == "" {
-		h.Missing("The client_id attribute is required for %s session migration", vendor)
-	}
-	if m.LoginIDMatchedAttributes.IsEmpty() {
-		h.Missing("The loginid_matched_attributes attribute is expected to be a non-empty list for %s session migration", vendor)
+// Returns the vendor specific attributes keyed by their names.
+func (m *SessionMigrationModel) vendorAttributes() map[string]stringattr.Type {
+	return map[string]stringattr.Type{
+		"client_id": m.ClientID,
+		"domain":    m.Domain,
+		"audience":  m.Audience,
+		"issuer":    m.Issuer,
+		"api_token": m.ApiToken,
 	}
 }
+
+// The attributes that a session migration vendor requires or doesn't support, in addition to
+// the client_id and loginid_matched_attributes attributes that are required by all of them.
+type sessionMigrationVendor struct {
+	Required  []string
+	Forbidden []string
+}
+
+// The vendors that sessions can be migrated from.
+var sessionMigrationVendors = map[string]sessionMigrationVendor{
+	"auth0": {
+		Required:  []string{"domain"},
+		Forbidden: []string{"issuer", "api_token"},
+	},
+	"okta": {
+		Required:  []string{"issuer", "api_token"},
+		Forbidden: []string{"domain", "audience"},
+	},
+}
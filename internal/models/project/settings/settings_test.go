@@ -383,6 +383,51 @@ func TestSettings(t *testing.T) {
 			`),
 			ExpectError: regexp.MustCompile(`api_token attribute should not be set`),
 		},
+		resource.TestStep{
+			Config: p.Config(`
+				project_settings = {
+					session_migration = {
+						vendor = "auth0"
+						client_id = "foo"
+						loginid_matched_attributes = [ "username", "email" ]
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`domain attribute is required for auth0`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				project_settings = {
+					session_migration = {
+						vendor = "auth0"
+						client_id = "foo"
+						domain = "bar"
+						loginid_matched_attributes = [ "username", "email" ]
+						user_mapping = [
+							{ external_key = "email", descope_key = "email" },
+							{ external_key = "mail", descope_key = "email" },
+						]
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`descope_key 'email' is mapped more than once`),
+		},
+		resource.TestStep{
+			Config: p.Config(`
+				project_settings = {
+					session_migration = {
+						vendor = "auth0"
+						client_id = "foo"
+						domain = "bar"
+						loginid_matched_attributes = [ "username", "email" ]
+						user_mapping = [
+							{ external_key = "dept", descope_key = "department" },
+						]
+					}
+				}
+			`),
+			ExpectError: regexp.MustCompile(`Unknown User Mapping Attribute`),
+		},
 		resource.TestStep{
 			Config: p.Config(`
 				project_settings = {
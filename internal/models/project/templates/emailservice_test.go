@@ -43,7 +43,7 @@ func TestEmail(t *testing.T) {
 							{
 								active = true
 								name = "foo"
-								html_body = "a"
+								html_body = "<p>Sign in with {{link}}</p>"
 								subject = "x"
 							}
 						]
@@ -56,12 +56,38 @@ func TestEmail(t *testing.T) {
 						templates = [
 							{
 								name = "foo"
-								html_body = "a"
+								html_body = "<p>Your code is {{code}}</p>"
+								subject = "x"
+							}
+						]
+					`)),
+			ExpectError: regexp.MustCompile(`must use {{link}} in its body`),
+		},
+		resource.TestStep{
+			Config: p.Config(emailService(`
+						connector = "Descope"
+						templates = [
+							{
+								name = "foo"
+								html_body = "<div>Sign in with {{link}}</span>"
+								subject = "x"
+							}
+						]
+					`)),
+			ExpectError: regexp.MustCompile(`the <div> element is closed by </span>`),
+		},
+		resource.TestStep{
+			Config: p.Config(emailService(`
+						connector = "Descope"
+						templates = [
+							{
+								name = "foo"
+								html_body = "<p>Sign in with {{link}}</p>"
 								subject = "x"
 							},
 							{
 								name = "foo"
-								html_body = "b"
+								html_body = "<p>Click {{link}}<br>to sign in</p>"
 								subject = "y"
 							}
 						]
@@ -74,12 +100,12 @@ func TestEmail(t *testing.T) {
 				templates = [
 					{
 						name = "foo"
-						html_body = "a"
+						html_body = "<p>Sign in with {{link}}</p>"
 						subject = "x"
 					},
 					{
 						name = "bar"
-						html_body = "b"
+						html_body = "<p>Click {{link}}<br>to sign in</p>"
 						subject = "y"
 					}
 				]
@@ -0,0 +1,180 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"golang.org/x/net/html"
+)
+
+// The kind of messages that the templates in a service are used to send, which is determined by
+// the authentication method or setting that owns the service.
+type MessageKind struct {
+	Name      string   // a name for the kind of messages in error messages
+	Required  []string // the message body must use at least one of these variables
+	Variables []string // other variables that can be used in this kind of message
+}
+
+var (
+	OTPMessages           = &MessageKind{Name: "OTP", Required: []string{"code"}}
+	MagicLinkMessages     = &MessageKind{Name: "magic link", Required: []string{"link"}}
+	EnchantedLinkMessages = &MessageKind{Name: "enchanted link", Required: []string{"link"}}
+	PasswordMessages      = &MessageKind{Name: "password reset", Required: []string{"link", "code"}}
+	InviteMessages        = &MessageKind{Name: "invite", Variables: []string{"link"}}
+	SSOMessages           = &MessageKind{Name: "SSO", Variables: []string{"link", "code"}}
+)
+
+// The variables that can be used in all kinds of messages.
+var messageVariables = []string{
+	"user.name", "user.givenName", "user.middleName", "user.familyName", "user.email", "user.phone", "user.loginId",
+	"project.name", "tenant.name",
+}
+
+const customAttributeVariablePrefix = "user.customAttributes."
+
+var messageVariableRegexp = regexp.MustCompile(`\{\{\{?\s*([^{}]*?)\s*\}?\}\}`)
+
+// Returns the variables used in a message, e.g., 'code' for '{{code}}', skipping block helpers
+// and comments such as '{{#if user.name}}' or '{{/if}}'.
+func messageVariablesIn(text string) []string {
+	result := []string{}
+	for _, match := range messageVariableRegexp.FindAllStringSubmatch(text, -1) {
+		v := match[1]
+		if v == "" || v == "else" || strings.ContainsAny(v[:1], "#/!>^") {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func (k *MessageKind) knows(variable string) bool {
+	if slices.Contains(k.Required, variable) || slices.Contains(k.Variables, variable) || slices.Contains(messageVariables, variable) {
+		return true
+	}
+	attribute, ok := strings.CutPrefix(variable, customAttributeVariablePrefix)
+	return ok && attribute != ""
+}
+
+// Checks that a message body uses one of the required variables, and that all the variables in
+// the message texts are known.
+func (k *MessageKind) check(h *helpers.Handler, template string, body string, texts ...string) {
+	if len(k.Required) > 0 && !slices.ContainsFunc(messageVariablesIn(body), func(v string) bool { return slices.Contains(k.Required, v) }) {
+		required := []string{}
+		for _, v := range k.Required {
+			required = append(required, "{{"+v+"}}")
+		}
+		h.Error("Missing Template Variable", "The %s template '%s' must use %s in its body", k.Name, template, strings.Join(required, " or "))
+	}
+	for _, text := range append([]string{body}, texts...) {
+		for _, v := range messageVariablesIn(text) {
+			if !k.knows(v) {
+				h.Warn("Unknown Template Variable", "The %s template '%s' uses an unknown variable {{%s}}", k.Name, template, v)
+			}
+		}
+	}
+}
+
+// Validates the templates in an email service for the kind of messages the service sends.
+func ValidateEmailService(h *helpers.Handler, service objattr.Type[EmailServiceModel], kind *MessageKind) {
+	s, _ := service.ToObject(h.Ctx)
+	if s == nil {
+		return
+	}
+	for t := range listattr.Iterator(s.Templates, h) {
+		if helpers.HasUnknownValues(t.Name, t.Subject, t.HTMLBody, t.PlainTextBody, t.UsePlainTextBody) {
+			continue
+		}
+		name := t.Name.ValueString()
+		if t.UsePlainTextBody.ValueBool() {
+			kind.check(h, name, t.PlainTextBody.ValueString(), t.Subject.ValueString(), t.HTMLBody.ValueString())
+		} else {
+			kind.check(h, name, t.HTMLBody.ValueString(), t.Subject.ValueString(), t.PlainTextBody.ValueString())
+			if err := checkHTML(t.HTMLBody.ValueString()); err != nil {
+				h.Error("Invalid HTML Body", "The html_body of the %s template '%s' is not well-formed: %s", kind.Name, name, err.Error())
+			}
+		}
+	}
+}
+
+// Validates the templates in a text service for the kind of messages the service sends.
+func ValidateTextService(h *helpers.Handler, service objattr.Type[TextServiceModel], kind *MessageKind) {
+	s, _ := service.ToObject(h.Ctx)
+	if s == nil {
+		return
+	}
+	for t := range listattr.Iterator(s.Templates, h) {
+		if !helpers.HasUnknownValues(t.Name, t.Body) {
+			kind.check(h, t.Name.ValueString(), t.Body.ValueString())
+		}
+	}
+}
+
+// Validates the templates in a voice service for the kind of messages the service sends.
+func ValidateVoiceService(h *helpers.Handler, service objattr.Type[VoiceServiceModel], kind *MessageKind) {
+	s, _ := service.ToObject(h.Ctx)
+	if s == nil {
+		return
+	}
+	for t := range listattr.Iterator(s.Templates, h) {
+		if !helpers.HasUnknownValues(t.Name, t.Body) {
+			kind.check(h, t.Name.ValueString(), t.Body.ValueString())
+		}
+	}
+}
+
+// Elements that don't have a closing tag.
+var voidElements = []string{"area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "source", "track", "wbr"}
+
+// Elements whose closing tag can be omitted.
+var optionalCloseElements = []string{"html", "head", "body", "p", "li", "dt", "dd", "option", "optgroup", "thead", "tbody", "tfoot", "tr", "td", "th", "colgroup", "rt", "rp"}
+
+// Checks that every element in the HTML is closed in the right order, except for elements whose
+// closing tag is optional.
+func checkHTML(text string) error {
+	stack := []string{}
+	tokenizer := html.NewTokenizer(strings.NewReader(text))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+				return err
+			}
+			for i := len(stack) - 1; i >= 0; i-- {
+				if !slices.Contains(optionalCloseElements, stack[i]) {
+					return fmt.Errorf("the <%s> element is never closed", stack[i])
+				}
+			}
+			return nil
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			if tag := string(name); !slices.Contains(voidElements, tag) {
+				stack = append(stack, tag)
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if slices.Contains(voidElements, tag) {
+				continue
+			}
+			i := len(stack) - 1
+			for i >= 0 && stack[i] != tag {
+				if !slices.Contains(optionalCloseElements, stack[i]) {
+					return fmt.Errorf("the <%s> element is closed by </%s>", stack[i], tag)
+				}
+				i--
+			}
+			if i < 0 {
+				return fmt.Errorf("the </%s> closing tag doesn't have a matching opening tag", tag)
+			}
+			stack = stack[:i]
+		}
+	}
+}
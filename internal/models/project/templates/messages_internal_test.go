@@ -0,0 +1,61 @@
+package templates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageVariables(t *testing.T) {
+	vars := messageVariablesIn("Hi {{ user.name }}, {{#if tenant.name}}{{tenant.name}}{{/if}} {{!comment}} {{{link}}} {{else}}")
+	assert.Equal(t, []string{"user.name", "tenant.name", "link"}, vars)
+}
+
+func TestMessageKindCheck(t *testing.T) {
+	check := func(kind *MessageKind, body string, texts ...string) (errors []string, warnings []string) {
+		diags := diag.Diagnostics{}
+		kind.check(helpers.NewHandler(context.Background(), &diags), "foo", body, texts...)
+		for _, d := range diags {
+			if d.Severity() == diag.SeverityError {
+				errors = append(errors, d.Detail())
+			} else {
+				warnings = append(warnings, d.Detail())
+			}
+		}
+		return
+	}
+
+	errors, warnings := check(OTPMessages, "Your code is {{code}}", "Hi {{user.customAttributes.nickname}}")
+	assert.Empty(t, errors)
+	assert.Empty(t, warnings)
+
+	errors, warnings = check(OTPMessages, "Your code is {{otpcode}}", "Code for {{project.name}}")
+	require.Len(t, errors, 1)
+	assert.Equal(t, "The OTP template 'foo' must use {{code}} in its body", errors[0])
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "The OTP template 'foo' uses an unknown variable {{otpcode}}", warnings[0])
+
+	errors, _ = check(PasswordMessages, "Reset with {{code}}")
+	assert.Empty(t, errors)
+
+	errors, _ = check(MagicLinkMessages, "Sign in with {{code}}")
+	require.Len(t, errors, 1)
+	assert.Contains(t, errors[0], "must use {{link}} in its body")
+
+	errors, warnings = check(InviteMessages, "Welcome", "{{link}}")
+	assert.Empty(t, errors)
+	assert.Empty(t, warnings)
+}
+
+func TestCheckHTML(t *testing.T) {
+	assert.NoError(t, checkHTML(`<html><body><p>Hi<br>there<p><a href="{{link}}">Sign in</a><img src="x"/></body></html>`))
+	assert.NoError(t, checkHTML(`<table><tr><td>a<td>b</table>`))
+	assert.NoError(t, checkHTML(`<!-- <div> --><style>div > p { color: red }</style>plain`))
+	assert.EqualError(t, checkHTML(`<div><span>a</div>`), "the <span> element is closed by </div>")
+	assert.EqualError(t, checkHTML(`<div>a</div></a>`), "the </a> closing tag doesn't have a matching opening tag")
+	assert.EqualError(t, checkHTML(`<div><p>a`), "the <div> element is never closed")
+}
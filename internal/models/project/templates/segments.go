@@ -0,0 +1,127 @@
+package templates
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// The encodings that an SMS message can be sent with.
+const (
+	SMSEncodingGSM7 = "GSM-7"
+	SMSEncodingUCS2 = "UCS-2"
+)
+
+// The characters in the GSM 03.38 basic character set, which take 1 septet each.
+const gsm7BasicCharacters = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// The characters in the GSM 03.38 extension table, which take 2 septets each as they're escaped.
+const gsm7ExtensionCharacters = "\f^{}\\[]~|€"
+
+// The maximum number of units that fit in a message that's sent in a single segment or in each
+// segment of a multi-part message, where a unit is a septet in GSM-7 and a UTF-16 code unit in UCS-2.
+var smsSegmentSizes = map[string][2]int{
+	SMSEncodingGSM7: {160, 153},
+	SMSEncodingUCS2: {70, 67},
+}
+
+// The assumed maximum length of the values that variables are replaced with when a message is
+// sent, which are used to compute the worst-case segment count of a template.
+var variableLengths = map[string]int{
+	"code":            6,
+	"link":            150,
+	"user.name":       64,
+	"user.givenName":  32,
+	"user.middleName": 32,
+	"user.familyName": 32,
+	"user.email":      64,
+	"user.phone":      16,
+	"user.loginId":    64,
+	"project.name":    64,
+	"tenant.name":     64,
+}
+
+// The assumed maximum length of the value of custom attributes and unknown variables.
+const defaultVariableLength = 32
+
+// The variables whose values are always made of GSM-7 characters, while the values of any
+// other variable, such as the user's name, might require the message to be sent as UCS-2.
+var gsm7Variables = map[string]bool{
+	"code":       true,
+	"link":       true,
+	"user.phone": true,
+}
+
+// Returns the encoding that a text message will be sent with and the worst-case number of
+// segments it will be split into, after its variables are replaced with values of the maximum
+// length. The encoding is UCS-2 if the text in the template isn't made of GSM-7 characters or
+// if it has variables whose values might not be.
+func AnalyzeTextMessage(body string) (encoding string, segments int) {
+	static := messageVariableRegexp.ReplaceAllString(body, "")
+	variables := messageVariablesIn(body)
+
+	encoding = SMSEncodingGSM7
+	for _, r := range static {
+		if !isGSM7(r) {
+			encoding = SMSEncodingUCS2
+			break
+		}
+	}
+	for _, v := range variables {
+		if !gsm7Variables[v] {
+			encoding = SMSEncodingUCS2
+			break
+		}
+	}
+
+	// the size of each character, without splitting a character across segments
+	sizes := []int{}
+	for _, r := range static {
+		sizes = append(sizes, characterSize(encoding, r))
+	}
+	for _, v := range variables {
+		length, ok := variableLengths[v]
+		if !ok {
+			length = defaultVariableLength
+		}
+		for range length {
+			sizes = append(sizes, 1)
+		}
+	}
+
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	if total == 0 {
+		return encoding, 0
+	}
+
+	limits := smsSegmentSizes[encoding]
+	if total <= limits[0] {
+		return encoding, 1
+	}
+
+	segments, used := 1, 0
+	for _, size := range sizes {
+		if used+size > limits[1] {
+			segments, used = segments+1, 0
+		}
+		used += size
+	}
+	return encoding, segments
+}
+
+func isGSM7(r rune) bool {
+	return strings.ContainsRune(gsm7BasicCharacters, r) || strings.ContainsRune(gsm7ExtensionCharacters, r)
+}
+
+func characterSize(encoding string, r rune) int {
+	if encoding == SMSEncodingUCS2 {
+		return utf16.RuneLen(r)
+	}
+	if strings.ContainsRune(gsm7ExtensionCharacters, r) {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,44 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeTextMessage(t *testing.T) {
+	analyze := func(body string) []any {
+		encoding, segments := AnalyzeTextMessage(body)
+		return []any{encoding, segments}
+	}
+
+	assert.Equal(t, []any{SMSEncodingGSM7, 0}, analyze(""))
+	assert.Equal(t, []any{SMSEncodingGSM7, 1}, analyze("Your code is {{code}}"))
+	assert.Equal(t, []any{SMSEncodingGSM7, 1}, analyze(strings.Repeat("a", 160)))
+	assert.Equal(t, []any{SMSEncodingGSM7, 2}, analyze(strings.Repeat("a", 161)))
+	assert.Equal(t, []any{SMSEncodingGSM7, 2}, analyze(strings.Repeat("a", 306)))
+	assert.Equal(t, []any{SMSEncodingGSM7, 3}, analyze(strings.Repeat("a", 307)))
+
+	// extension characters take two septets and aren't split across segments
+	assert.Equal(t, []any{SMSEncodingGSM7, 1}, analyze(strings.Repeat("€", 80)))
+	assert.Equal(t, []any{SMSEncodingGSM7, 2}, analyze(strings.Repeat("€", 81)))
+	assert.Equal(t, []any{SMSEncodingGSM7, 3}, analyze(strings.Repeat("a", 152)+strings.Repeat("€", 77)))
+
+	// a single character outside of GSM-7 switches the whole message to UCS-2
+	assert.Equal(t, []any{SMSEncodingUCS2, 1}, analyze("Your code is {{code}} ✓"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 2}, analyze(strings.Repeat("a", 70)+"✓"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 1}, analyze(strings.Repeat("😀", 35)))
+	assert.Equal(t, []any{SMSEncodingUCS2, 2}, analyze(strings.Repeat("😀", 36)))
+
+	// variables are expanded to their maximum length
+	assert.Equal(t, []any{SMSEncodingGSM7, 1}, analyze("{{link}}"))
+	assert.Equal(t, []any{SMSEncodingGSM7, 2}, analyze("Sign in to your account with this link: {{link}}"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 3}, analyze("Привет, войдите по ссылке {{link}}"))
+
+	// variables that might have values outside of GSM-7 switch the whole message to UCS-2
+	assert.Equal(t, []any{SMSEncodingGSM7, 1}, analyze("Your code is {{code}}, call {{user.phone}} if this wasn't you"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 1}, analyze("Hi {{user.givenName}}"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 4}, analyze("Hi {{user.name}}, sign in with {{link}}"))
+	assert.Equal(t, []any{SMSEncodingUCS2, 2}, analyze("Your code for {{project.name}} is {{code}}"))
+}
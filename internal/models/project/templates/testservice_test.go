@@ -43,7 +43,7 @@ func TestText(t *testing.T) {
 							{
 								active = true
 								name = "foo"
-								body = "a"
+								body = "Your code is {{code}}"
 							}
 						]
 					`)),
@@ -55,11 +55,23 @@ func TestText(t *testing.T) {
 						templates = [
 							{
 								name = "foo"
-								body = "a"
+								body = "Sign in with {{link}}"
+							}
+						]
+					`)),
+			ExpectError: regexp.MustCompile(`must use {{code}} in its body`),
+		},
+		resource.TestStep{
+			Config: p.Config(textService(`
+						connector = "Descope"
+						templates = [
+							{
+								name = "foo"
+								body = "Your code is {{code}}"
 							},
 							{
 								name = "foo"
-								body = "b"
+								body = "Code: {{code}}"
 							}
 						]
 					`)),
@@ -68,22 +80,27 @@ func TestText(t *testing.T) {
 		resource.TestStep{
 			Config: p.Config(textService(`
 				connector = "Descope"
+				segment_budget = 1
 				templates = [
 					{
 						name = "foo"
-						body = "a"
+						body = "Your code is {{code}}"
 					},
 					{
 						name = "bar"
-						body = "b"
+						body = "Código: {{code}} ✓"
 					}
 				]
 			`)),
 			Check: p.Check(map[string]any{
-				"authentication.otp.text_service.connector":        "Descope",
-				"authentication.otp.text_service.templates.#":      2,
-				"authentication.otp.text_service.templates.0.name": "foo",
-				"authentication.otp.text_service.templates.1.name": "bar",
+				"authentication.otp.text_service.connector":            "Descope",
+				"authentication.otp.text_service.segment_budget":       1,
+				"authentication.otp.text_service.templates.#":          2,
+				"authentication.otp.text_service.templates.0.name":     "foo",
+				"authentication.otp.text_service.templates.0.encoding": "GSM-7",
+				"authentication.otp.text_service.templates.1.name":     "bar",
+				"authentication.otp.text_service.templates.1.encoding": "UCS-2",
+				"authentication.otp.text_service.templates.1.segments": 1,
 			}),
 		},
 		resource.TestStep{
@@ -93,7 +110,7 @@ func TestText(t *testing.T) {
 					{
 						active = true
 						name = "foo"
-						body = "a"
+						body = "Your code is {{code}}"
 					}
 				]
 			`)),
@@ -137,7 +154,7 @@ func TestText(t *testing.T) {
 								{
 									active = true
 									name = "foo"
-									body = "bar"
+									body = "Your {{project.name}} code is {{code}}"
 								}
 							]
 						}
@@ -145,14 +162,16 @@ func TestText(t *testing.T) {
 				}
 			`),
 			Check: p.Check(map[string]any{
-				"connectors.generic_sms_gateway.#":                   1,
-				"connectors.twilio_core.#":                           1,
-				"authentication.magic_link.text_service.connector":   "Generic SMS Gateway Connector",
-				"authentication.otp.text_service.connector":          "Twilio Core Connector",
-				"authentication.otp.text_service.templates.#":        1,
-				"authentication.otp.text_service.templates.0.active": true,
-				"authentication.otp.text_service.templates.0.name":   "foo",
-				"authentication.otp.text_service.templates.0.body":   "bar",
+				"connectors.generic_sms_gateway.#":                     1,
+				"connectors.twilio_core.#":                             1,
+				"authentication.magic_link.text_service.connector":     "Generic SMS Gateway Connector",
+				"authentication.otp.text_service.connector":            "Twilio Core Connector",
+				"authentication.otp.text_service.templates.#":          1,
+				"authentication.otp.text_service.templates.0.active":   true,
+				"authentication.otp.text_service.templates.0.name":     "foo",
+				"authentication.otp.text_service.templates.0.body":     "Your {{project.name}} code is {{code}}",
+				"authentication.otp.text_service.templates.0.encoding": "GSM-7",
+				"authentication.otp.text_service.templates.0.segments": 1,
 			}),
 		},
 	)
@@ -1,23 +1,27 @@
 package templates
 
 import (
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/listattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 var TextServiceValidator = objattr.NewValidator[TextServiceModel]("must have unique template names and a valid configuration")
 
 var TextServiceAttributes = map[string]schema.Attribute{
-	"connector": stringattr.Required(),
-	"templates": listattr.Default[TextTemplateModel](TextTemplateAttributes, TextTemplateValidator),
+	"connector":      stringattr.Required(),
+	"templates":      listattr.Default[TextTemplateModel](TextTemplateAttributes, TextTemplateValidator, TextTemplateModifier),
+	"segment_budget": intattr.Default(0, int64validator.AtLeast(0)),
 }
 
 type TextServiceModel struct {
-	Connector stringattr.Type                  `tfsdk:"connector"`
-	Templates listattr.Type[TextTemplateModel] `tfsdk:"templates"`
+	Connector     stringattr.Type                  `tfsdk:"connector"`
+	Templates     listattr.Type[TextTemplateModel] `tfsdk:"templates"`
+	SegmentBudget intattr.Type                     `tfsdk:"segment_budget"`
 }
 
 func (m *TextServiceModel) Values(h *helpers.Handler) map[string]any {
@@ -35,6 +39,9 @@ func (m *TextServiceModel) Values(h *helpers.Handler) map[string]any {
 
 func (m *TextServiceModel) SetValues(h *helpers.Handler, data map[string]any) {
 	stringattr.Set(&m.Connector, data, "textServiceProvider")
+	if m.SegmentBudget.IsNull() || m.SegmentBudget.IsUnknown() {
+		m.SegmentBudget = intattr.Value(0) // only used by terraform when planning
+	}
 
 	if m.Templates.IsEmpty() {
 		listattr.Set(&m.Templates, data, "textTemplates", h)
@@ -75,6 +82,17 @@ func (m *TextServiceModel) Validate(h *helpers.Handler) {
 		}
 	}
 
+	if budget := m.SegmentBudget.ValueInt64(); budget > 0 && !m.SegmentBudget.IsUnknown() {
+		for v := range listattr.Iterator(m.Templates, h) {
+			if v.Body.IsUnknown() {
+				continue
+			}
+			if encoding, segments := AnalyzeTextMessage(v.Body.ValueString()); int64(segments) > budget {
+				h.Warn("SMS Segment Budget Exceeded", "The text template '%s' might be sent as %d %s segments which exceeds the segment budget of %d", v.Name.ValueString(), segments, encoding, budget)
+			}
+		}
+	}
+
 	connector := m.Connector.ValueString()
 	if hasActive && connector == helpers.DescopeConnector {
 		h.Error("Invalid text service connector", "The connector attribute must not be set to Descope if any template is marked as active")
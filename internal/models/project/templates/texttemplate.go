@@ -2,6 +2,7 @@ package templates
 
 import (
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/boolattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
 	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
@@ -10,18 +11,24 @@ import (
 
 var TextTemplateValidator = objattr.NewValidator[TextTemplateModel]("must have a valid name")
 
+var TextTemplateModifier = objattr.NewModifier[TextTemplateModel]("computes the encoding and segment count of the text message", objattr.ModifierAllowNullState)
+
 var TextTemplateAttributes = map[string]schema.Attribute{
-	"active": boolattr.Default(false),
-	"id":     stringattr.Identifier(),
-	"name":   stringattr.Required(),
-	"body":   stringattr.Required(),
+	"active":   boolattr.Default(false),
+	"id":       stringattr.Identifier(),
+	"name":     stringattr.Required(),
+	"body":     stringattr.Required(),
+	"encoding": stringattr.Generated(),
+	"segments": intattr.Generated(),
 }
 
 type TextTemplateModel struct {
-	Active boolattr.Type   `tfsdk:"active"`
-	ID     stringattr.Type `tfsdk:"id"`
-	Name   stringattr.Type `tfsdk:"name"`
-	Body   stringattr.Type `tfsdk:"body"`
+	Active   boolattr.Type   `tfsdk:"active"`
+	ID       stringattr.Type `tfsdk:"id"`
+	Name     stringattr.Type `tfsdk:"name"`
+	Body     stringattr.Type `tfsdk:"body"`
+	Encoding stringattr.Type `tfsdk:"encoding"`
+	Segments intattr.Type    `tfsdk:"segments"`
 }
 
 func (m *TextTemplateModel) Values(h *helpers.Handler) map[string]any {
@@ -38,6 +45,7 @@ func (m *TextTemplateModel) SetValues(h *helpers.Handler, data map[string]any) {
 	boolattr.Set(&m.Active, data, "active")
 	stringattr.Set(&m.Name, data, "name")
 	stringattr.Set(&m.Body, data, "body")
+	m.analyze()
 }
 
 func (m *TextTemplateModel) Validate(h *helpers.Handler) {
@@ -45,3 +53,16 @@ func (m *TextTemplateModel) Validate(h *helpers.Handler) {
 		h.Error("Invalid text template", "Cannot use 'System' as the name or id of a template")
 	}
 }
+
+func (m *TextTemplateModel) Modify(h *helpers.Handler, _ *TextTemplateModel) {
+	m.analyze()
+}
+
+func (m *TextTemplateModel) analyze() {
+	if m.Body.IsUnknown() || m.Body.IsNull() {
+		return
+	}
+	encoding, segments := AnalyzeTextMessage(m.Body.ValueString())
+	m.Encoding = stringattr.Value(encoding)
+	m.Segments = intattr.Value(int64(segments))
+}
@@ -0,0 +1,215 @@
+package rotation
+
+import (
+	"time"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/durationattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/strmapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The attribute for the rotation settings of a credential that remains active for an overlap
+// period after it's rotated, which is null unless configured.
+func Attribute(extras ...any) schema.SingleNestedAttribute {
+	return objattr.Default[RotationModel](nil, RotationAttributes, extras...)
+}
+
+// The attribute for the rotation settings of a secret that the backend replaces right away when
+// it's rotated, which doesn't have an overlap period, and is null unless configured.
+func SecretAttribute(extras ...any) schema.SingleNestedAttribute {
+	return objattr.Default[SecretRotationModel](nil, SecretRotationAttributes, extras...)
+}
+
+var RotationAttributes = map[string]schema.Attribute{
+	"rotate_every": durationattr.Optional(),
+	"keepers":      strmapattr.Default(),
+	"overlap":      durationattr.Default("1 day"),
+}
+
+type RotationModel struct {
+	RotateEvery durationattr.Type `tfsdk:"rotate_every"`
+	Keepers     strmapattr.Type   `tfsdk:"keepers"`
+	Overlap     durationattr.Type `tfsdk:"overlap"`
+}
+
+// The rotation settings are only used by the provider and aren't sent to the backend.
+func (m *RotationModel) Values(h *helpers.Handler) map[string]any {
+	return map[string]any{}
+}
+
+func (m *RotationModel) SetValues(h *helpers.Handler, data map[string]any) {
+	stringattr.Nil(&m.RotateEvery)
+}
+
+var SecretRotationAttributes = map[string]schema.Attribute{
+	"rotate_every": durationattr.Optional(),
+	"keepers":      strmapattr.Default(),
+}
+
+type SecretRotationModel struct {
+	RotateEvery durationattr.Type `tfsdk:"rotate_every"`
+	Keepers     strmapattr.Type   `tfsdk:"keepers"`
+}
+
+// The rotation settings are only used by the provider and aren't sent to the backend.
+func (m *SecretRotationModel) Values(h *helpers.Handler) map[string]any {
+	return map[string]any{}
+}
+
+func (m *SecretRotationModel) SetValues(h *helpers.Handler, data map[string]any) {
+	stringattr.Nil(&m.RotateEvery)
+}
+
+// An Action is a rotation step that's planned for a credential.
+type Action int
+
+const (
+	// Nothing needs to be done.
+	ActionNone Action = iota
+	// A new credential is issued, and for credentials with an overlap period the current one
+	// becomes the previous credential.
+	ActionRotate
+	// The previous credential is deactivated after the overlap period has passed.
+	ActionRevoke
+)
+
+// The Fields of a resource model that take part in the rotation of its credential. Credentials
+// that are rotated by creating a new entity in the backend (e.g., access keys) remain active for
+// an overlap period, and set the Rotation, PreviousSecret and PreviousID fields. Secrets that the
+// backend replaces right away when it issues a new one set the SecretRotation field instead, and
+// don't have a previous secret since it stops working when the secret is rotated.
+type Fields struct {
+	Rotation       *objattr.Type[RotationModel]
+	SecretRotation *objattr.Type[SecretRotationModel]
+	Secret         *stringattr.Type
+	PreviousSecret *stringattr.Type
+	PreviousID     *stringattr.Type
+	RotatedTime    *intattr.Type
+}
+
+// Decides which rotation action is needed for a credential, given its planned fields and its fields
+// in the state, where state is nil when the resource is being created. The credential is rotated when
+// the keepers change or when it's older than the rotate_every duration, and for credentials with an
+// overlap period the previous credential is revoked once the overlap duration has passed since the
+// last rotation.
+func Plan(h *helpers.Handler, plan Fields, state *Fields, now time.Time) Action {
+	if state == nil || state.RotatedTime.IsNull() || state.RotatedTime.IsUnknown() {
+		return ActionNone
+	}
+
+	rotation, unknown := plan.settings(h)
+	hasPrevious := state.PreviousSecret != nil && state.PreviousSecret.ValueString() != ""
+	if rotation == nil {
+		if hasPrevious && !unknown {
+			return ActionRevoke // revoke any previous credential right away when rotation is disabled
+		}
+		return ActionNone
+	}
+
+	rotatedAt := time.Unix(state.RotatedTime.ValueInt64(), 0)
+
+	if previous, _ := state.settings(h); previous != nil && !rotation.Keepers.IsUnknown() && !rotation.Keepers.Equal(previous.Keepers) {
+		return ActionRotate
+	}
+	if every, ok := durationattr.Duration(rotation.RotateEvery); ok && !now.Before(rotatedAt.Add(every)) {
+		return ActionRotate
+	}
+	if overlap, ok := durationattr.Duration(rotation.Overlap); ok && hasPrevious && !now.Before(rotatedAt.Add(overlap)) {
+		return ActionRevoke
+	}
+	return ActionNone
+}
+
+// Updates the planned fields for a rotation action, so that the plan shows which values will
+// change when it's applied.
+func (f Fields) Apply(action Action) {
+	switch action {
+	case ActionRotate:
+		*f.Secret = types.StringUnknown()
+		*f.RotatedTime = types.Int64Unknown()
+		if f.PreviousSecret != nil {
+			*f.PreviousSecret = types.StringUnknown()
+		}
+		if f.PreviousID != nil {
+			*f.PreviousID = types.StringUnknown()
+		}
+	case ActionRevoke:
+		*f.PreviousSecret = stringattr.Value("")
+	}
+}
+
+// Returns the rotation action that was planned, by comparing the planned fields with the
+// fields in the state when the plan is applied.
+func Pending(plan Fields, state Fields) Action {
+	if plan.RotatedTime.IsUnknown() && !state.RotatedTime.IsNull() && !state.RotatedTime.IsUnknown() {
+		return ActionRotate
+	}
+	if plan.PreviousSecret != nil && plan.PreviousSecret.ValueString() == "" && state.PreviousSecret.ValueString() != "" && !plan.PreviousSecret.IsUnknown() {
+		return ActionRevoke
+	}
+	return ActionNone
+}
+
+// Sets the fields after a new credential was issued, where the credential from the state
+// becomes the previous credential if it remains active.
+func (f Fields) Rotated(state Fields, previousID string, now time.Time) {
+	if f.PreviousSecret != nil {
+		*f.PreviousSecret = stringattr.Value(state.Secret.ValueString())
+	}
+	if f.PreviousID != nil {
+		*f.PreviousID = stringattr.Value(previousID)
+	}
+	*f.RotatedTime = intattr.Value(now.Unix())
+}
+
+// Ensures all the rotation fields have known values after a resource is created or read,
+// e.g., for resources that were created with an older version of the provider, in which
+// case the rotation time starts when the resource is first tracked.
+func (f Fields) Normalize(h *helpers.Handler, now time.Time) {
+	if f.Rotation != nil {
+		if rotation, _ := f.Rotation.ToObject(h.Ctx); rotation != nil && rotation.RotateEvery.IsUnknown() {
+			rotation.SetValues(h, nil)
+			*f.Rotation = objattr.Value(rotation)
+		}
+	}
+	if f.SecretRotation != nil {
+		if rotation, _ := f.SecretRotation.ToObject(h.Ctx); rotation != nil && rotation.RotateEvery.IsUnknown() {
+			rotation.SetValues(h, nil)
+			*f.SecretRotation = objattr.Value(rotation)
+		}
+	}
+	if f.RotatedTime.IsNull() || f.RotatedTime.IsUnknown() {
+		*f.RotatedTime = intattr.Value(now.Unix())
+	}
+	if f.PreviousSecret != nil && (f.PreviousSecret.IsNull() || f.PreviousSecret.IsUnknown()) {
+		*f.PreviousSecret = stringattr.Value("")
+	}
+	if f.PreviousID != nil && (f.PreviousID.IsNull() || f.PreviousID.IsUnknown()) {
+		*f.PreviousID = stringattr.Value("")
+	}
+}
+
+// Returns whether rotation is configured for the credential.
+func (f Fields) Enabled(h *helpers.Handler) bool {
+	rotation, _ := f.settings(h)
+	return rotation != nil
+}
+
+// Returns the rotation settings of the credential, with a null overlap for secrets that are
+// replaced right away, and whether the settings are unknown.
+func (f Fields) settings(h *helpers.Handler) (*RotationModel, bool) {
+	if f.SecretRotation != nil {
+		rotation, _ := f.SecretRotation.ToObject(h.Ctx)
+		if rotation == nil {
+			return nil, f.SecretRotation.IsUnknown()
+		}
+		return &RotationModel{RotateEvery: rotation.RotateEvery, Keepers: rotation.Keepers, Overlap: types.StringNull()}, false
+	}
+	rotation, _ := f.Rotation.ToObject(h.Ctx)
+	return rotation, f.Rotation.IsUnknown()
+}
@@ -0,0 +1,212 @@
+package rotation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/intattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/objattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/strmapattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type model struct {
+	Rotation       objattr.Type[RotationModel]
+	Secret         stringattr.Type
+	PreviousSecret stringattr.Type
+	PreviousID     stringattr.Type
+	RotatedTime    intattr.Type
+}
+
+func (m *model) fields() Fields {
+	return Fields{Rotation: &m.Rotation, Secret: &m.Secret, PreviousSecret: &m.PreviousSecret, PreviousID: &m.PreviousID, RotatedTime: &m.RotatedTime}
+}
+
+func newModel(rotateEvery string, keepers map[string]string, previous string, rotated time.Time) *model {
+	rotation := objattr.Value[RotationModel](nil)
+	if rotateEvery != "" || keepers != nil {
+		rotation = objattr.Value(&RotationModel{
+			RotateEvery: stringattr.Value(rotateEvery),
+			Keepers:     strmapattr.Value(keepers),
+			Overlap:     stringattr.Value("1 day"),
+		})
+	}
+	return &model{
+		Rotation:       rotation,
+		Secret:         stringattr.Value("current"),
+		PreviousSecret: stringattr.Value(previous),
+		PreviousID:     stringattr.Value(""),
+		RotatedTime:    intattr.Value(rotated.Unix()),
+	}
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	plan := func(plan, state *model) Action {
+		if state == nil {
+			return Plan(h, plan.fields(), nil, now)
+		}
+		f := state.fields()
+		return Plan(h, plan.fields(), &f, now)
+	}
+
+	// nothing is rotated on create or before the rotation is due
+	assert.Equal(t, ActionNone, plan(newModel("30 days", nil, "", now), nil))
+	state := newModel("30 days", nil, "", now.Add(-10*24*time.Hour))
+	assert.Equal(t, ActionNone, plan(newModel("30 days", nil, "", now), state))
+
+	// the credential is rotated when it's older than rotate_every
+	state = newModel("30 days", nil, "", now.Add(-30*24*time.Hour))
+	assert.Equal(t, ActionRotate, plan(newModel("30 days", nil, "", now), state))
+
+	// the credential is rotated when the keepers change
+	state = newModel("", map[string]string{"version": "1"}, "", now.Add(-time.Hour))
+	assert.Equal(t, ActionNone, plan(newModel("", map[string]string{"version": "1"}, "", now), state))
+	assert.Equal(t, ActionRotate, plan(newModel("", map[string]string{"version": "2"}, "", now), state))
+
+	// the previous credential is revoked after the overlap duration
+	state = newModel("30 days", nil, "previous", now.Add(-time.Hour))
+	assert.Equal(t, ActionNone, plan(newModel("30 days", nil, "previous", now), state))
+	state = newModel("30 days", nil, "previous", now.Add(-2*24*time.Hour))
+	assert.Equal(t, ActionRevoke, plan(newModel("30 days", nil, "previous", now), state))
+
+	// the previous credential is revoked right away when rotation is removed
+	state = newModel("30 days", nil, "previous", now.Add(-time.Hour))
+	assert.Equal(t, ActionRevoke, plan(newModel("", nil, "previous", now), state))
+
+	require.False(t, diags.HasError())
+}
+
+func TestApplyPending(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a planned rotation is detected when the plan is applied
+	state := newModel("30 days", nil, "", now.Add(-30*24*time.Hour))
+	plan := newModel("30 days", nil, "", now)
+	plan.fields().Apply(ActionRotate)
+	assert.True(t, plan.Secret.IsUnknown())
+	assert.True(t, plan.PreviousID.IsUnknown())
+	assert.Equal(t, ActionRotate, Pending(plan.fields(), state.fields()))
+
+	// the credential in the state becomes the previous credential
+	plan.Secret = stringattr.Value("new")
+	plan.fields().Rotated(state.fields(), "K1", now)
+	assert.Equal(t, "current", plan.PreviousSecret.ValueString())
+	assert.Equal(t, "K1", plan.PreviousID.ValueString())
+	assert.Equal(t, now.Unix(), plan.RotatedTime.ValueInt64())
+
+	// a planned revocation is detected when the plan is applied
+	state = newModel("30 days", nil, "previous", now.Add(-2*24*time.Hour))
+	plan = newModel("30 days", nil, "previous", now)
+	plan.fields().Apply(ActionRevoke)
+	assert.Equal(t, "", plan.PreviousSecret.ValueString())
+	assert.Equal(t, ActionRevoke, Pending(plan.fields(), state.fields()))
+
+	assert.Equal(t, ActionNone, Pending(state.fields(), state.fields()))
+}
+
+func TestNormalize(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	m := &model{
+		Rotation:       objattr.Value[RotationModel](nil),
+		Secret:         stringattr.Value("current"),
+		PreviousSecret: types.StringUnknown(),
+		PreviousID:     types.StringNull(),
+		RotatedTime:    types.Int64Unknown(),
+	}
+	m.fields().Normalize(h, now)
+	assert.Equal(t, "", m.PreviousSecret.ValueString())
+	assert.False(t, m.PreviousSecret.IsUnknown())
+	assert.Equal(t, "", m.PreviousID.ValueString())
+	assert.Equal(t, now.Unix(), m.RotatedTime.ValueInt64())
+	require.False(t, diags.HasError())
+}
+
+type secretModel struct {
+	Rotation    objattr.Type[SecretRotationModel]
+	Secret      stringattr.Type
+	RotatedTime intattr.Type
+}
+
+func (m *secretModel) fields() Fields {
+	return Fields{SecretRotation: &m.Rotation, Secret: &m.Secret, RotatedTime: &m.RotatedTime}
+}
+
+func newSecretModel(rotateEvery string, keepers map[string]string, rotated time.Time) *secretModel {
+	rotation := objattr.Value[SecretRotationModel](nil)
+	if rotateEvery != "" || keepers != nil {
+		rotation = objattr.Value(&SecretRotationModel{
+			RotateEvery: stringattr.Value(rotateEvery),
+			Keepers:     strmapattr.Value(keepers),
+		})
+	}
+	return &secretModel{
+		Rotation:    rotation,
+		Secret:      stringattr.Value("current"),
+		RotatedTime: intattr.Value(rotated.Unix()),
+	}
+}
+
+func TestSecretRotation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	plan := func(plan, state *secretModel) Action {
+		f := state.fields()
+		return Plan(h, plan.fields(), &f, now)
+	}
+
+	// the secret is rotated when it's older than rotate_every or when the keepers change
+	state := newSecretModel("30 days", nil, now.Add(-10*24*time.Hour))
+	assert.Equal(t, ActionNone, plan(newSecretModel("30 days", nil, now), state))
+	state = newSecretModel("30 days", nil, now.Add(-30*24*time.Hour))
+	assert.Equal(t, ActionRotate, plan(newSecretModel("30 days", nil, now), state))
+	state = newSecretModel("", map[string]string{"version": "1"}, now.Add(-time.Hour))
+	assert.Equal(t, ActionRotate, plan(newSecretModel("", map[string]string{"version": "2"}, now), state))
+
+	// there's no previous secret to revoke, either after the rotation or when it's removed
+	state = newSecretModel("30 days", nil, now.Add(-2*24*time.Hour))
+	assert.Equal(t, ActionNone, plan(newSecretModel("", nil, now), state))
+
+	// the rotated secret replaces the one in the state
+	state = newSecretModel("30 days", nil, now.Add(-30*24*time.Hour))
+	m := newSecretModel("30 days", nil, now)
+	m.fields().Apply(ActionRotate)
+	assert.True(t, m.Secret.IsUnknown())
+	assert.Equal(t, ActionRotate, Pending(m.fields(), state.fields()))
+	m.Secret = stringattr.Value("new")
+	m.fields().Rotated(state.fields(), "", now)
+	assert.Equal(t, "new", m.Secret.ValueString())
+	assert.Equal(t, now.Unix(), m.RotatedTime.ValueInt64())
+
+	m.RotatedTime = types.Int64Unknown()
+	m.fields().Normalize(h, now)
+	assert.Equal(t, now.Unix(), m.RotatedTime.ValueInt64())
+
+	require.False(t, diags.HasError())
+}
+
+func TestEnabled(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	diags := diag.Diagnostics{}
+	h := helpers.NewHandler(context.Background(), &diags)
+
+	assert.True(t, newModel("30 days", nil, "", now).fields().Enabled(h))
+	assert.False(t, newModel("", nil, "", now).fields().Enabled(h))
+	assert.True(t, newSecretModel("", map[string]string{"version": "1"}, now).fields().Enabled(h))
+	assert.False(t, newSecretModel("", nil, now).fields().Enabled(h))
+	require.False(t, diags.HasError())
+}
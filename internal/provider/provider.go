@@ -3,15 +3,24 @@ package provider
 import (
 	"context"
 	"os"
+	"strings"
 
+	"github.com/descope/terraform-provider-descope/internal/datasources"
 	"github.com/descope/terraform-provider-descope/internal/infra"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
 	"github.com/descope/terraform-provider-descope/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -35,6 +44,13 @@ type descopeProviderConfig struct {
 	ProjectID     types.String `tfsdk:"project_id"`
 	ManagementKey types.String `tfsdk:"management_key"`
 	BaseURL       types.String `tfsdk:"base_url"`
+	Policy        types.Object `tfsdk:"policy"`
+	PolicyFile    types.String `tfsdk:"policy_file"`
+}
+
+type descopeProviderPolicy struct {
+	ProductionChecks types.String `tfsdk:"production_checks"`
+	SkipChecks       types.Set    `tfsdk:"skip_checks"`
 }
 
 func (p *descopeProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -59,6 +75,27 @@ func (p *descopeProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Description: "An optional base URL for the Descope API",
 			},
+			"policy_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a local file with custom policy rules that are evaluated when planning changes to projects, access keys and management keys",
+			},
+			"policy": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Determines how risky settings in projects with the `production` environment are reported",
+				Attributes: map[string]schema.Attribute{
+					"production_checks": schema.StringAttribute{
+						Optional:    true,
+						Description: "Whether failed checks are reported as a `warning` (the default), an `error` that fails the plan, or are `disabled` entirely",
+						Validators:  []validator.String{stringvalidator.OneOf(policy.Enforcements...)},
+					},
+					"skip_checks": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "A set of checks that should not be run, any of: `" + strings.Join(policy.Checks, "`, `") + "`",
+						Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(policy.Checks...))},
+					},
+				},
+			},
 		},
 	}
 }
@@ -75,6 +112,12 @@ func (p *descopeProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if config.ManagementKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(path.Root("management_key"), "Unknown Descope Management Key", "The provider cannot create the Descope client as there is an unknown configuration value for the Descope management key. Either target apply the source of the value first, set the value statically in the configuration, or use the DESCOPE_MANAGEMENT_KEY environment variable.")
 	}
+	if helpers.HasUnknownValues(config.Policy) {
+		resp.Diagnostics.AddAttributeError(path.Root("policy"), "Unknown Descope Policy", "The provider cannot be configured as there is an unknown configuration value in the policy block. Either target apply the source of the value first or set the value statically in the configuration.")
+	}
+	if config.PolicyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_file"), "Unknown Descope Policy File", "The provider cannot be configured as there is an unknown configuration value for the policy file. Either target apply the source of the value first, set the value statically in the configuration, or use the DESCOPE_POLICY_FILE environment variable.")
+	}
 	if config.BaseURL.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(path.Root("base_url"), "Unknown Descope Base URL", "The provider cannot create the Descope client as there is an unknown configuration value for the Descope base URL. Either target apply the source of the value first, set the value statically in the configuration, or use the DESCOPE_BASE_URL environment variable.")
 	}
@@ -96,6 +139,11 @@ func (p *descopeProvider) Configure(ctx context.Context, req provider.ConfigureR
 		baseURL = config.BaseURL.ValueString()
 	}
 
+	policyFile := os.Getenv("DESCOPE_POLICY_FILE")
+	if !config.PolicyFile.IsNull() {
+		policyFile = config.PolicyFile.ValueString()
+	}
+
 	if managementKey == "" {
 		resp.Diagnostics.AddAttributeError(path.Root("management_key"), "Missing Descope Management Key", "The provider cannot create the Descope client as there is a missing or empty value for the Descope management key. Set the management_key value in the configuration or use the DESCOPE_MANAGEMENT_KEY environment variable. If either is already set, ensure the value is not empty.")
 	}
@@ -103,15 +151,49 @@ func (p *descopeProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client := infra.NewClient(p.version, managementKey, baseURL)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerPolicy := loadPolicy(ctx, config.Policy, &resp.Diagnostics)
+	if policyFile != "" {
+		rules, err := policy.LoadRules(policyFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("policy_file"), "Invalid Descope Policy File", "The provider cannot load the custom policy rules: "+err.Error())
+			return
+		}
+		providerPolicy.Rules = rules
+	}
+
+	data := &resources.ProviderData{
+		Client: infra.NewClient(ctx, p.version, managementKey, baseURL, resources.SensitiveKeys(ctx, p.Resources(ctx))),
+		Policy: providerPolicy,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured Descope provider")
 }
 
+// Creates the policy for checking production projects from the policy block in the provider configuration.
+func loadPolicy(ctx context.Context, object types.Object, diags *diag.Diagnostics) *policy.Policy {
+	result := &policy.Policy{Enforcement: policy.EnforcementWarning}
+	if object.IsNull() {
+		return result
+	}
+
+	var config descopeProviderPolicy
+	diags.Append(object.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+
+	if v := config.ProductionChecks.ValueString(); v != "" {
+		result.Enforcement = v
+	}
+	if !config.SkipChecks.IsNull() {
+		diags.Append(config.SkipChecks.ElementsAs(ctx, &result.SkipChecks, false)...)
+	}
+	return result
+}
+
 func (p *descopeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		datasources.NewJWTPreviewDataSource,
+	}
 }
 
 func (p *descopeProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -122,5 +204,6 @@ func (p *descopeProvider) Resources(_ context.Context) []func() resource.Resourc
 		resources.NewAccessKeyResource,
 		resources.NewInboundAppResource,
 		resources.NewEngineResource,
+		resources.NewFGARelationsResource,
 	}
 }
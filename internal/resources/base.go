@@ -8,9 +8,12 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/infra"
 	"github.com/descope/terraform-provider-descope/internal/models/accesskey"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,36 +30,107 @@ var (
 	_ resource.Resource                = &baseResource[accesskey.AccessKeyModel, *accesskey.AccessKeyModel]{}
 	_ resource.ResourceWithConfigure   = &baseResource[accesskey.AccessKeyModel, *accesskey.AccessKeyModel]{}
 	_ resource.ResourceWithImportState = &baseResource[accesskey.AccessKeyModel, *accesskey.AccessKeyModel]{}
+	_ resource.ResourceWithIdentity    = &baseResource[accesskey.AccessKeyModel, *accesskey.AccessKeyModel]{}
+	_ resource.ResourceWithModifyPlan  = &baseResource[accesskey.AccessKeyModel, *accesskey.AccessKeyModel]{}
 )
 
 type baseResource[T any, M helpers.ResourceModel[T]] struct {
 	name   string
 	schema schema.Schema
 	client *infra.Client
+	policy *policy.Policy
 }
 
 func (r *baseResource[T, M]) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*infra.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*ProviderData); ok {
+		r.client = data.Client
+		r.policy = data.Policy
 	}
 }
 
 func (r *baseResource[T, M]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + r.name
+	if _, ok := any(M(new(T))).(rotatingModel); ok {
+		resp.ResourceBehavior.MutableIdentity = true // the id changes when a credential is rotated by creating a new entity
+	}
 }
 
 func (r *baseResource[T, M]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = r.schema
 }
 
+func (r *baseResource[T, M]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = makeIdentitySchema(r.isProjectLevel())
+}
+
+func (r *baseResource[T, M]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // nothing to check when the resource is being destroyed
+	}
+
+	model := M(new(T))
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
+
+	if modifier, ok := any(model).(helpers.PlanModifierModel[T]); ok {
+		var state *T
+		if !req.State.Raw.IsNull() {
+			state = new(T)
+			resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.RequiresReplace = append(resp.RequiresReplace, modifier.ModifyPlan(handler, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, model)...)
+	}
+
+	if r.client == nil || !r.policy.HasRules(r.name) {
+		return // nothing else to check when there are no custom policy rules for the resource
+	}
+
+	if !isConfigKnown(ctx, req.Config) {
+		return
+	}
+
+	values := model.Values(handler)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.policy.EvaluateRules(handler, r.name, values)
+}
+
+// Returns whether the configuration has no unknown values, i.e., values that depend on other resources
+// that haven't been applied yet, in which case the custom policy rules can only be evaluated in a later
+// plan, as the conversion of the data for the rules expects the configured values to be known.
+func isConfigKnown(ctx context.Context, config tfsdk.Config) bool {
+	if config.Raw.IsFullyKnown() {
+		return true
+	}
+	tflog.Info(ctx, "Skipping custom policy rules as the configuration has values that are not known yet")
+	return false
+}
+
 func (r *baseResource[T, M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "create", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	model := M(new(T))
 	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(model.GetProjectID().ValueString())
 
 	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
 	values := model.Values(handler)
@@ -76,13 +150,17 @@ func (r *baseResource[T, M]) Create(ctx context.Context, req resource.CreateRequ
 
 	model.SetID(types.StringValue(res.ID))
 	model.SetValues(handler, res.Data)
+	normalizeRotation(handler, model)
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, r.isProjectLevel(), model.GetProjectID(), model.GetID(), &resp.Diagnostics)
 
 	tflog.Info(ctx, "Created "+r.name+" resource")
 }
 
 func (r *baseResource[T, M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "Reading "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "read", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
 	ctx = helpers.ContextWithImportState(ctx, req, resp)
 
 	model := M(new(T))
@@ -90,6 +168,7 @@ func (r *baseResource[T, M]) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(model.GetProjectID().ValueString())
 
 	res, err := r.client.Read(ctx, model.GetProjectID().ValueString(), r.name, model.GetID().ValueString())
 	if err != nil {
@@ -99,19 +178,24 @@ func (r *baseResource[T, M]) Read(ctx context.Context, req resource.ReadRequest,
 
 	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
 	model.SetValues(handler, res.Data)
+	normalizeRotation(handler, model)
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, r.isProjectLevel(), model.GetProjectID(), model.GetID(), &resp.Diagnostics)
 
 	tflog.Info(ctx, "Read "+r.name+" resource")
 }
 
 func (r *baseResource[T, M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "Updating "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "update", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	model := M(new(T))
 	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(model.GetProjectID().ValueString())
 
 	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
 	values := model.Values(handler)
@@ -119,30 +203,62 @@ func (r *baseResource[T, M]) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	res, err := r.client.Update(ctx, model.GetProjectID().ValueString(), r.name, model.GetID().ValueString(), values)
-	if failure, ok := infra.AsValidationError(err); ok {
-		resp.Diagnostics.AddError("Invalid "+r.name+" configuration", failure)
-		return
+	var res *infra.Response
+	rotating, isRotating := any(model).(rotatingModel)
+	state := M(new(T))
+	if isRotating {
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res = r.applyRotation(ctx, rotating, any(state).(rotatingModel), model.GetProjectID().ValueString(), values, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating "+r.name, err.Error())
-		return
+
+	if res == nil {
+		var err error
+		res, err = r.client.Update(ctx, model.GetProjectID().ValueString(), r.name, model.GetID().ValueString(), values)
+		if failure, ok := infra.AsValidationError(err); ok {
+			resp.Diagnostics.AddError("Invalid "+r.name+" configuration", failure)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating "+r.name, err.Error())
+			return
+		}
 	}
 
 	model.SetValues(handler, res.Data)
+	if isRotating {
+		finishRotation(rotating, any(state).(rotatingModel), &resp.Diagnostics)
+		normalizeRotation(handler, model)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, r.isProjectLevel(), model.GetProjectID(), model.GetID(), &resp.Diagnostics)
 
 	tflog.Info(ctx, "Updated "+r.name+" resource")
 }
 
 func (r *baseResource[T, M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "Deleting "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "delete", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	model := M(new(T))
 	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(model.GetProjectID().ValueString())
+
+	if rotating, ok := any(model).(rotatingModel); ok {
+		r.deletePrevious(ctx, rotating, model.GetProjectID().ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	err := r.client.Delete(ctx, model.GetProjectID().ValueString(), r.name, model.GetID().ValueString())
 	if err != nil {
@@ -155,19 +271,66 @@ func (r *baseResource[T, M]) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *baseResource[T, M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "import", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
 	helpers.MarkImportState(ctx, resp)
 
-	if _, ok := r.schema.Attributes["project_id"]; !ok {
-		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var projectID, id string
+	if req.ID == "" {
+		if r.isProjectLevel() {
+			projectID = getIdentityAttribute(ctx, req.Identity, "project_id", &resp.Diagnostics)
+		}
+		id = getIdentityAttribute(ctx, req.Identity, "id", &resp.Diagnostics)
+	} else if r.isProjectLevel() {
+		parts := strings.SplitN(req.ID, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Import ID must be in the format 'project_id/%s_id'%s", r.name, r.importLookupFormats("project_id/")))
+			return
+		}
+		projectID, id = parts[0], r.resolveImportID(ctx, parts[0], parts[1], &resp.Diagnostics)
+	} else {
+		id = r.resolveImportID(ctx, infra.NoProjectID, req.ID, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Import ID must be in the format 'project_id/%s_id'", r.name))
-		return
+	if r.isProjectLevel() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Import IDs can also be in the form of 'field:value' for resources that support looking up
+// their ID by another field, e.g., 'name:CI Key' for access keys or 'email:foo@example.com'
+// for descopers, in which case we use the client to find the matching resource ID.
+func (r *baseResource[T, M]) resolveImportID(ctx context.Context, projectID, value string, diagnostics *diag.Diagnostics) string {
+	for _, field := range infra.LookupFields(r.name) {
+		if v, ok := strings.CutPrefix(value, field+":"); ok {
+			id, err := r.client.Lookup(ctx, projectID, r.name, field, v)
+			if err != nil {
+				diagnostics.AddError("Error importing "+r.name, err.Error())
+			}
+			return id
+		}
+	}
+	return value
+}
+
+func (r *baseResource[T, M]) importLookupFormats(prefix string) string {
+	var formats []string
+	for _, field := range infra.LookupFields(r.name) {
+		formats = append(formats, fmt.Sprintf("'%s%s:<%s>'", prefix, field, field))
+	}
+	if len(formats) == 0 {
+		return ""
 	}
+	return " or " + strings.Join(formats, " or ")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+// Resources with a project_id attribute in their schema are project-level resources (like access keys
+// or inbound apps), otherwise they are company-level resources (like management keys or descopers).
+func (r *baseResource[T, M]) isProjectLevel() bool {
+	_, ok := r.schema.Attributes["project_id"]
+	return ok
 }
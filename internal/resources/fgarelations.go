@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/descope/terraform-provider-descope/internal/infra"
+	"github.com/descope/terraform-provider-descope/internal/models/fgarelations"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/project/authorization/fga"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &fgaRelationsResource{}
+	_ resource.ResourceWithConfigure   = &fgaRelationsResource{}
+	_ resource.ResourceWithImportState = &fgaRelationsResource{}
+	_ resource.ResourceWithIdentity    = &fgaRelationsResource{}
+	_ resource.ResourceWithModifyPlan  = &fgaRelationsResource{}
+)
+
+func NewFGARelationsResource() resource.Resource {
+	base := newResource[fgarelations.FGARelationsModel]("fga_relations", fgarelations.Schema)
+	return &fgaRelationsResource{base.(*baseResource[fgarelations.FGARelationsModel, *fgarelations.FGARelationsModel])}
+}
+
+// A resource that manages relation tuples in the project's FGA schema. Unlike other resources it
+// doesn't own an entity in the backend, since the application usually creates relations of the
+// same resource types as well, so it uses the FGA management API routes instead of the infra
+// API. Updates only create or delete the relations that were added or removed, reads check which
+// of the relations still hold, and deleting the resource only removes the relations it manages.
+type fgaRelationsResource struct {
+	*baseResource[fgarelations.FGARelationsModel, *fgarelations.FGARelationsModel]
+}
+
+func (r *fgaRelationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.baseResource.Metadata(ctx, req, resp)
+	resp.ResourceBehavior.MutableIdentity = true // the id changes when the relations change
+}
+
+func (r *fgaRelationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.baseResource.ModifyPlan(ctx, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := &fgarelations.FGARelationsModel{}
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// there's no need to read the project again if the relations didn't change
+	if !req.State.Raw.IsNull() {
+		state := &fgarelations.FGARelationsModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() || model.Relations.Equal(state.Relations) {
+			return
+		}
+	}
+
+	// the schema might be updated by the project resource in the same plan, so only warn for now
+	r.checkSchema(ctx, helpers.NewHandler(ctx, &resp.Diagnostics), model, false)
+}
+
+func (r *fgaRelationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "create", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
+
+	model := &fgarelations.FGARelationsModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op.SetProjectID(model.GetProjectID().ValueString())
+
+	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
+	r.checkSchema(ctx, handler, model, true)
+	tuples := model.Tuples(handler)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateRelations(ctx, model.GetProjectID().ValueString(), tuples); err != nil {
+		resp.Diagnostics.AddError("Error creating "+r.name, err.Error())
+		return
+	}
+
+	model.SetID(types.StringValue(model.MakeID(handler)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, true, model.GetProjectID(), model.GetID(), &resp.Diagnostics)
+
+	tflog.Info(ctx, "Created "+r.name+" resource")
+}
+
+func (r *fgaRelationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "read", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
+
+	model := &fgarelations.FGARelationsModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op.SetProjectID(model.GetProjectID().ValueString())
+
+	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
+	if model.Relations.IsNull() {
+		model.SetRelationsFromID(handler) // the resource is being imported
+	}
+	tuples := model.Tuples(handler)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := r.client.CheckRelations(ctx, model.GetProjectID().ValueString(), tuples)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading "+r.name, err.Error())
+		return
+	}
+
+	model.SetValues(handler, map[string]any{"tuples": results})
+	if model.Relations.IsEmpty() {
+		tflog.Info(ctx, "None of the relations in the "+r.name+" resource exist anymore")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model.SetID(types.StringValue(model.MakeID(handler)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, true, model.GetProjectID(), model.GetID(), &resp.Diagnostics)
+
+	tflog.Info(ctx, "Read "+r.name+" resource")
+}
+
+func (r *fgaRelationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "update", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
+
+	model, state := &fgarelations.FGARelationsModel{}, &fgarelations.FGARelationsModel{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op.SetProjectID(model.GetProjectID().ValueString())
+
+	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
+	r.checkSchema(ctx, handler, model, true)
+	create, remove := model.Changes(handler, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := model.GetProjectID().ValueString()
+	if len(remove) > 0 {
+		if err := r.client.DeleteRelations(ctx, projectID, remove); err != nil {
+			resp.Diagnostics.AddError("Error updating "+r.name, err.Error())
+			return
+		}
+	}
+	if len(create) > 0 {
+		if err := r.client.CreateRelations(ctx, projectID, create); err != nil {
+			resp.Diagnostics.AddError("Error updating "+r.name, err.Error())
+			return
+		}
+	}
+
+	model.SetID(types.StringValue(model.MakeID(handler)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentity(ctx, resp.Identity, true, model.GetProjectID(), model.GetID(), &resp.Diagnostics)
+
+	tflog.Info(ctx, "Updated "+r.name+" resource")
+}
+
+func (r *fgaRelationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting "+r.name+" resource")
+	ctx, op := infra.StartOperation(ctx, "delete", r.name)
+	defer op.End(ctx, &resp.Diagnostics)
+
+	model := &fgarelations.FGARelationsModel{}
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	op.SetProjectID(model.GetProjectID().ValueString())
+
+	handler := helpers.NewHandler(ctx, &resp.Diagnostics)
+	tuples := model.Tuples(handler)
+	if resp.Diagnostics.HasError() || len(tuples) == 0 {
+		return
+	}
+
+	if err := r.client.DeleteRelations(ctx, model.GetProjectID().ValueString(), tuples); err != nil {
+		resp.Diagnostics.AddError("Error deleting "+r.name, err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted "+r.name+" resource")
+}
+
+// Validates the relations against the FGA schema of the project. When strict is false the
+// failures are reported as warnings and the project is only read once for all the resources
+// being planned, otherwise the failures are reported as errors and the project is read again
+// in case its schema was updated while applying.
+func (r *fgaRelationsResource) checkSchema(ctx context.Context, h *helpers.Handler, model *fgarelations.FGARelationsModel, strict bool) {
+	projectID := model.GetProjectID()
+	if r.client == nil || projectID.IsUnknown() || helpers.HasUnknownValues(model.Relations) {
+		return // nothing to check when the project is being created or the provider isn't configured
+	}
+
+	read := r.client.Read
+	if !strict {
+		read = r.client.PlanRead
+	}
+
+	res, err := read(ctx, projectID.ValueString(), projectEntity, projectID.ValueString())
+	if err != nil {
+		if strict {
+			h.Error("Error reading FGA schema", "Failed to read the FGA schema of the project: %s", err.Error())
+		} else {
+			h.Log("Skipping FGA relations check after failing to read project: %s", err.Error())
+		}
+		return
+	}
+
+	authorization, _ := res.Data["authorization"].(map[string]any)
+	text, _ := authorization["fga"].(string)
+	if strings.TrimSpace(text) == "" {
+		r.reportSchema(h, strict, []string{"The project doesn't have an FGA schema, make sure the 'fga' attribute is set in the project's authorization settings"})
+		return
+	}
+
+	schema, errs := fga.Parse(text)
+	if len(errs) > 0 {
+		h.Log("Skipping FGA relations check as the project's FGA schema has errors: %s", errs[0].Error())
+		return
+	}
+
+	r.reportSchema(h, strict, model.Check(h, schema))
+}
+
+func (r *fgaRelationsResource) reportSchema(h *helpers.Handler, strict bool, failures []string) {
+	for _, failure := range failures {
+		if strict {
+			h.Error("Invalid FGA Relation", "%s", failure)
+		} else {
+			h.Warn("Invalid FGA Relation", "%s. Applying the plan will fail unless the FGA schema is updated in the same plan.", failure)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Returns the identity schema for a resource, which always contains the resource's id and, for
+// project-level resources, the id of the project the resource belongs to. The identity allows
+// Terraform 1.12+ to import resources using an import block with an identity attribute.
+func makeIdentitySchema(projectLevel bool) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "The unique identifier of the resource",
+		},
+	}
+	if projectLevel {
+		attributes["project_id"] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "The id of the project the resource belongs to",
+		}
+	}
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// Updates the resource identity after a create, read or update operation, if Terraform supports it.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, projectLevel bool, projectID, id types.String, diagnostics *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	if projectLevel {
+		diagnostics.Append(identity.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+	diagnostics.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Reads a string attribute from the resource identity in an import request.
+func getIdentityAttribute(ctx context.Context, identity *tfsdk.ResourceIdentity, name string, diagnostics *diag.Diagnostics) string {
+	var value types.String
+	if identity != nil {
+		diagnostics.Append(identity.GetAttribute(ctx, path.Root(name), &value)...)
+	}
+	return value.ValueString()
+}
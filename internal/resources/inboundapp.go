@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/stringattr"
+	"github.com/descope/terraform-provider-descope/internal/models/attrs/strsetattr"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/inboundapp"
+	"github.com/descope/terraform-provider-descope/internal/models/project"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource               = &inboundAppResource{}
+	_ resource.ResourceWithModifyPlan = &inboundAppResource{}
+)
+
+func NewInboundAppResource() resource.Resource {
+	base := newResource[inboundapp.InboundAppModel]("inbound_app", inboundapp.Schema)
+	return &inboundAppResource{base.(*baseResource[inboundapp.InboundAppModel, *inboundapp.InboundAppModel])}
+}
+
+// An inbound app resource that also checks its callback URLs against the approved domains of
+// its project when planning, as they aren't part of the project resource's configuration. The
+// project is read from the backend, so any unapproved URLs are only reported as warnings.
+type inboundAppResource struct {
+	*baseResource[inboundapp.InboundAppModel, *inboundapp.InboundAppModel]
+}
+
+func (r *inboundAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.baseResource.ModifyPlan(ctx, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := &inboundapp.InboundAppModel{}
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkCallbackURLs(ctx, helpers.NewHandler(ctx, &resp.Diagnostics), model)
+}
+
+func (r *inboundAppResource) checkCallbackURLs(ctx context.Context, h *helpers.Handler, model *inboundapp.InboundAppModel) {
+	projectID := model.GetProjectID()
+	if r.client == nil || projectID.IsUnknown() || model.ApprovedCallbackUrls.IsUnknown() || model.ApprovedCallbackUrls.IsEmpty() {
+		return // nothing to check when the project is being created or the provider isn't configured
+	}
+
+	res, err := r.client.PlanRead(ctx, projectID.ValueString(), projectEntity, projectID.ValueString())
+	if err != nil {
+		h.Log("Skipping inbound app callback URLs check after failing to read project: %s", err.Error())
+		return
+	}
+
+	checker := project.NewRedirectURLChecker(res.Data)
+	if checker == nil {
+		return // nothing to validate against if there are no trusted domains
+	}
+
+	for v := range strsetattr.Iterator(model.ApprovedCallbackUrls, h) {
+		checker.Check(h, stringattr.Value(v), "approved_callback_urls of the '"+model.Name.ValueString()+"' inbound app")
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/entities"
 	"github.com/descope/terraform-provider-descope/internal/infra"
 	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -19,7 +21,9 @@ var (
 	_ resource.Resource                   = &projectResource{}
 	_ resource.ResourceWithConfigure      = &projectResource{}
 	_ resource.ResourceWithValidateConfig = &projectResource{}
+	_ resource.ResourceWithModifyPlan     = &projectResource{}
 	_ resource.ResourceWithImportState    = &projectResource{}
+	_ resource.ResourceWithIdentity       = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -28,11 +32,13 @@ func NewProjectResource() resource.Resource {
 
 type projectResource struct {
 	client *infra.Client
+	policy *policy.Policy
 }
 
 func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*infra.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*ProviderData); ok {
+		r.client = data.Client
+		r.policy = data.Policy
 	}
 }
 
@@ -44,19 +50,50 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = entities.ProjectSchema
 }
 
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = makeIdentitySchema(false)
+}
+
 func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	tflog.Info(ctx, "Validating project resource")
+	ctx, op := infra.StartOperation(ctx, "validate", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	entity := entities.NewProjectEntity(ctx, req.Config, &resp.Diagnostics)
 	if entity.Diagnostics.HasError() {
 		return
 	}
 
+	entity.Validate(ctx)
+	if entity.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "Project resource validated")
 }
 
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return // nothing to check when the project is being destroyed or the provider isn't configured
+	}
+
+	entity := entities.NewProjectEntity(ctx, req.Plan, &resp.Diagnostics)
+	if entity.Diagnostics.HasError() {
+		return
+	}
+
+	entity.CheckPolicy(ctx, r.policy)
+	if entity.Diagnostics.HasError() || !isConfigKnown(ctx, req.Config) {
+		return
+	}
+
+	entity.EvaluateRules(ctx, r.policy)
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating project resource")
+	ctx, op := infra.StartOperation(ctx, "create", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	entity := entities.NewProjectEntity(ctx, req.Plan, &resp.Diagnostics)
 	if entity.Diagnostics.HasError() {
@@ -79,14 +116,18 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	entity.SetProjectID(ctx, res.ID)
+	op.SetProjectID(res.ID)
 	entity.SetValues(ctx, res.Data)
 	entity.Save(ctx, &resp.State)
+	setIdentity(ctx, resp.Identity, false, types.StringNull(), entity.Model.ID, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Project resource created")
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "Reading project resource")
+	ctx, op := infra.StartOperation(ctx, "read", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 	ctx = helpers.ContextWithImportState(ctx, req, resp)
 
 	entity := entities.NewProjectEntity(ctx, req.State, &resp.Diagnostics)
@@ -98,6 +139,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if entity.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(projectID)
 
 	res, err := r.client.Read(ctx, projectID, projectEntity, projectID)
 	if err != nil {
@@ -107,12 +149,15 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	entity.SetValues(ctx, res.Data)
 	entity.Save(ctx, &resp.State)
+	setIdentity(ctx, resp.Identity, false, types.StringNull(), entity.Model.ID, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Project resource read")
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "Updating project resource")
+	ctx, op := infra.StartOperation(ctx, "update", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	entity := entities.NewProjectEntity(ctx, req.Plan, &resp.Diagnostics)
 	if entity.Diagnostics.HasError() {
@@ -124,6 +169,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if entity.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(projectID)
 
 	res, err := r.client.Update(ctx, projectID, projectEntity, projectID, values)
 	if failure, ok := infra.AsValidationError(err); ok {
@@ -137,12 +183,15 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	entity.SetValues(ctx, res.Data)
 	entity.Save(ctx, &resp.State)
+	setIdentity(ctx, resp.Identity, false, types.StringNull(), entity.Model.ID, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Project resource updated")
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "Deleting project resource")
+	ctx, op := infra.StartOperation(ctx, "delete", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 
 	entity := entities.NewProjectEntity(ctx, req.State, &resp.Diagnostics)
 	if entity.Diagnostics.HasError() {
@@ -153,6 +202,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if entity.Diagnostics.HasError() {
 		return
 	}
+	op.SetProjectID(projectID)
 
 	err := r.client.Delete(ctx, projectID, projectEntity, projectID)
 	if err != nil {
@@ -165,6 +215,8 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing project resource")
+	ctx, op := infra.StartOperation(ctx, "import", projectEntity)
+	defer op.End(ctx, &resp.Diagnostics)
 	helpers.MarkImportState(ctx, resp)
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/descope/terraform-provider-descope/internal/entities"
+	"github.com/descope/terraform-provider-descope/internal/infra"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+const testFlowData = `{"metadata":{},"contents":{}}`
+
+// TestProjectModifyPlanUnknownValues verifies that the custom policy rules are only evaluated
+// when the configuration doesn't have values that depend on resources that weren't applied yet.
+func TestProjectModifyPlanUnknownValues(t *testing.T) {
+	ctx := context.Background()
+
+	rules, err := policy.ParseRules([]byte(`
+		rule "no-sign-up" {
+		  condition = !can(input.flows["sign-up"])
+		  message   = "The sign-up flow is not allowed"
+		}
+	`), "policy.hcl")
+	require.NoError(t, err)
+
+	r := &projectResource{client: &infra.Client{}, policy: &policy.Policy{Enforcement: policy.EnforcementWarning, Rules: rules}}
+
+	modifyPlan := func(data tftypes.Value) *resource.ModifyPlanResponse {
+		typ := entities.ProjectSchema.Type().TerraformType(ctx).(tftypes.Object)
+		raw := nullObject(typ, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"flows": tftypes.NewValue(typ.AttributeTypes["flows"], map[string]tftypes.Value{
+				"sign-up": nullObject(typ.AttributeTypes["flows"].(tftypes.Map).ElementType.(tftypes.Object), map[string]tftypes.Value{"data": data}),
+			}),
+		})
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: entities.ProjectSchema, Raw: raw},
+			Plan:   tfsdk.Plan{Schema: entities.ProjectSchema, Raw: raw},
+			State:  tfsdk.State{Schema: entities.ProjectSchema, Raw: tftypes.NewValue(typ, nil)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		require.NotPanics(t, func() { r.ModifyPlan(ctx, req, resp) })
+		return resp
+	}
+
+	// the rules aren't evaluated while the flow data is unknown
+	resp := modifyPlan(tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	// the rules are evaluated once the flow data is known
+	resp = modifyPlan(tftypes.NewValue(tftypes.String, testFlowData))
+	require.True(t, resp.Diagnostics.HasError())
+	require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "The sign-up flow is not allowed")
+}
+
+// Returns an object value of the given type where all attributes are null except the given ones.
+func nullObject(typ tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	for name, t := range typ.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(t, nil)
+		}
+	}
+	return tftypes.NewValue(typ, attrs)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/descope/terraform-provider-descope/internal/infra"
+	"github.com/descope/terraform-provider-descope/internal/models/policy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// The data that the provider passes to its resources once it's configured, with the client for
+// making requests to the backend and the policy for checking planned changes.
+type ProviderData struct {
+	Client *infra.Client
+	Policy *policy.Policy
+}
+
+// Returns the names of the sensitive attributes in the schemas of the resources, so that their
+// values are redacted from request and response logs.
+func SensitiveKeys(ctx context.Context, newResources []func() resource.Resource) infra.SensitiveKeys {
+	schemas := []map[string]schema.Attribute{}
+	for _, newResource := range newResources {
+		resp := resource.SchemaResponse{}
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+		schemas = append(schemas, resp.Schema.Attributes)
+	}
+	return infra.NewSensitiveKeys(schemas...)
+}
@@ -0,0 +1,18 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSensitiveKeys verifies that the sensitive attributes are collected from the schemas of
+// the resources, including those in nested objects.
+func TestSensitiveKeys(t *testing.T) {
+	keys := SensitiveKeys(context.Background(), []func() resource.Resource{NewProjectResource, NewAccessKeyResource})
+	assert.True(t, keys["previouscleartext"])
+	assert.True(t, keys["secretaccesskey"])
+	assert.False(t, keys["name"])
+}
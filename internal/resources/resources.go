@@ -4,7 +4,6 @@ import (
 	"github.com/descope/terraform-provider-descope/internal/models/accesskey"
 	"github.com/descope/terraform-provider-descope/internal/models/descoper"
 	"github.com/descope/terraform-provider-descope/internal/models/engine"
-	"github.com/descope/terraform-provider-descope/internal/models/inboundapp"
 	"github.com/descope/terraform-provider-descope/internal/models/managementkey"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -21,10 +20,6 @@ func NewManagementKeyResource() resource.Resource {
 	return newResource[managementkey.ManagementKeyModel]("management_key", managementkey.Schema)
 }
 
-func NewInboundAppResource() resource.Resource {
-	return newResource[inboundapp.InboundAppModel]("inbound_app", inboundapp.Schema)
-}
-
 func NewEngineResource() resource.Resource {
 	return newResource[engine.EngineModel]("engine", engine.Schema)
 }
@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/descope/go-sdk/descope"
+	"github.com/descope/terraform-provider-descope/internal/infra"
+	"github.com/descope/terraform-provider-descope/internal/models/helpers"
+	"github.com/descope/terraform-provider-descope/internal/models/rotation"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// A resource model with a credential that can be rotated, see the rotation package.
+type rotatingModel interface {
+	RotationFields() rotation.Fields
+	GetID() types.String
+	SetID(id types.String)
+}
+
+// Performs the rotation action that was planned for a resource before the rest of the update
+// is applied. If the credential was rotated by creating a new entity the response for the new
+// entity is returned, in which case the update shouldn't be sent to the backend.
+//
+// Credentials with their own entity (e.g., access keys) are rotated by creating a new entity
+// with the planned values, deleting the entity of any earlier previous credential, and later
+// deactivating the previous entity by updating its status. Other credentials are rotated by
+// the backend issuing a new secret that replaces the current one, so there's nothing to revoke.
+func (r *baseResource[T, M]) applyRotation(ctx context.Context, model, state rotatingModel, projectID string, values map[string]any, diagnostics *diag.Diagnostics) *infra.Response {
+	fields, stateFields := model.RotationFields(), state.RotationFields()
+	action := rotation.Pending(fields, stateFields)
+	if action == rotation.ActionNone {
+		return nil
+	}
+
+	if fields.PreviousID == nil {
+		if action == rotation.ActionRotate {
+			tflog.Info(ctx, "Rotating "+r.name+" secret")
+			secret, err := r.client.RotateSecret(ctx, projectID, r.name, model.GetID().ValueString())
+			if err != nil {
+				diagnostics.AddError("Error rotating "+r.name+" secret", err.Error())
+				return nil
+			}
+			*fields.Secret = types.StringValue(secret)
+		}
+		return nil
+	}
+
+	if action == rotation.ActionRevoke {
+		tflog.Info(ctx, "Deactivating previous "+r.name)
+		previous := map[string]any{"status": "inactive"}
+		if _, err := r.client.Update(ctx, projectID, r.name, stateFields.PreviousID.ValueString(), previous); err != nil && !descope.IsNotFoundError(err) {
+			diagnostics.AddError("Error deactivating previous "+r.name, err.Error())
+		}
+		return nil
+	}
+
+	if id := stateFields.PreviousID.ValueString(); id != "" {
+		tflog.Info(ctx, "Deleting earlier previous "+r.name)
+		if err := r.client.Delete(ctx, projectID, r.name, id); err != nil && !descope.IsNotFoundError(err) {
+			diagnostics.AddError("Error deleting previous "+r.name, err.Error())
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, "Rotating "+r.name)
+	res, err := r.client.Create(ctx, projectID, r.name, values)
+	if failure, ok := infra.AsValidationError(err); ok {
+		diagnostics.AddError("Invalid "+r.name+" configuration", failure)
+		return nil
+	}
+	if err != nil {
+		diagnostics.AddError("Error rotating "+r.name, err.Error())
+		return nil
+	}
+
+	model.SetID(types.StringValue(res.ID))
+	return res
+}
+
+// Sets the rotation fields after the planned values were applied, where the credential in the
+// state becomes the previous credential if it was rotated.
+func finishRotation(model, state rotatingModel, diagnostics *diag.Diagnostics) {
+	fields := model.RotationFields()
+	if rotation.Pending(fields, state.RotationFields()) != rotation.ActionRotate {
+		return
+	}
+	if fields.Secret.IsUnknown() || fields.Secret.ValueString() == "" {
+		*fields.Secret = types.StringValue("")
+		diagnostics.AddWarning("Missing rotated secret", "The credential was rotated but the response from the server did not include the new secret, so it's not available in the state")
+	}
+	fields.Rotated(state.RotationFields(), state.GetID().ValueString(), time.Now())
+}
+
+// Deletes the entity of the previous credential when a resource is deleted.
+func (r *baseResource[T, M]) deletePrevious(ctx context.Context, model rotatingModel, projectID string, diagnostics *diag.Diagnostics) {
+	fields := model.RotationFields()
+	if fields.PreviousID == nil || fields.PreviousID.ValueString() == "" {
+		return
+	}
+	tflog.Info(ctx, "Deleting previous "+r.name)
+	if err := r.client.Delete(ctx, projectID, r.name, fields.PreviousID.ValueString()); err != nil && !descope.IsNotFoundError(err) {
+		diagnostics.AddError("Error deleting previous "+r.name, err.Error())
+	}
+}
+
+// Ensures the rotation fields have known values after the resource is created or read.
+func normalizeRotation(h *helpers.Handler, model any) {
+	if m, ok := model.(rotatingModel); ok {
+		m.RotationFields().Normalize(h, time.Now())
+	}
+}
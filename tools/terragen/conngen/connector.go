@@ -102,6 +102,12 @@ func (c *Connector) HasEnumFields() bool {
 	return false
 }
 
+func (c *Connector) HasKeyMaterialFields() bool {
+	return slices.ContainsFunc(c.Fields, func(f *Field) bool {
+		return f.KeyMaterialValidator() != ""
+	})
+}
+
 func (c *Connector) HasValuesDependency() bool {
 	for _, f := range c.Fields {
 		if f.Dependency != nil && f.Dependency.Field.Type == FieldTypeString && len(f.Dependency.Values) > 0 {
@@ -142,6 +148,13 @@ func (c *Connector) Prepare() {
 		c.Fields = append(c.Fields, UseStaticIPsField)
 	}
 
+	// add a sensitive_headers map field after each regular headers field
+	for i := len(c.Fields) - 1; i >= 0; i-- {
+		if f := c.Fields[i]; f.isHeaders() {
+			c.Fields = slices.Insert(c.Fields, i+1, newSensitiveField(f))
+		}
+	}
+
 	for _, f := range c.Fields {
 		// treat these types as regular string fields for now
 		if f.Type == "readonly-string" {
@@ -153,9 +166,10 @@ func (c *Connector) Prepare() {
 			f.Type = FieldTypeSecret
 		}
 
-		// treat secret-object fields are regular object fields until we add support for it
+		// secret-object fields are object fields whose values are hidden in plan output
 		if f.Type == "secret-object" {
 			f.Type = FieldTypeObject
+			f.Sensitive = true
 		}
 
 		if d := f.Dependency; d != nil {
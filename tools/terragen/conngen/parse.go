@@ -63,15 +63,16 @@ func AddBoilerplate(conns *Connectors, sc *schema.Schema) {
 }
 
 func updateBoilerplate(conns *Connectors, sc *schema.Schema, strip bool) {
+	key := &schema.Field{Name: "key", Description: utils.DefaultConnectorKeyText}
 	name := &schema.Field{Name: "name", Description: utils.DefaultConnectorNameText}
 	desc := &schema.Field{Name: "description", Description: utils.DefaultConnectorDescriptionText}
 	for _, c := range conns.Connectors {
 		model := findConnectorModel(sc, c.StructName())
 		model.Generated = !c.BuiltIn
 		if strip {
-			model.Fields = slices.DeleteFunc(model.Fields, func(f *schema.Field) bool { return f.Name == name.Name || f.Name == desc.Name })
+			model.Fields = slices.DeleteFunc(model.Fields, func(f *schema.Field) bool { return f.Name == key.Name || f.Name == name.Name || f.Name == desc.Name })
 		} else {
-			model.Fields = slices.Concat([]*schema.Field{name}, []*schema.Field{desc}, model.Fields)
+			model.Fields = slices.Concat([]*schema.Field{key}, []*schema.Field{name}, []*schema.Field{desc}, model.Fields)
 		}
 	}
 }
@@ -16,6 +16,11 @@ const PlaceholderDescription = `// description for`
 // defaults for common connector fields
 const DefaultConnectorNameText = "A custom name for your connector."
 const DefaultConnectorDescriptionText = "A description of what your connector is used for."
+const DefaultConnectorKeyText = `A persistent value that identifies a connector uniquely across plan changes and configuration
+updates. It is used exclusively by the Terraform provider during planning, to ensure that connectors
+keep their identifiers, and that any flows that use them keep working, even when connector names or
+other details are changed. Once the ` + "`key`" + ` is set it should never be changed, otherwise the connector
+will be removed and a new one will be created instead.`
 
 // markdown descriptions with paragraphs will require a custom template to look good
 const preserveParagraphs = false
@@ -39,6 +39,10 @@ func Engine(t *testing.T) *Resource {
 	return newResource(t, "engine")
 }
 
+func FGARelations(t *testing.T) *Resource {
+	return newResource(t, "fga_relations")
+}
+
 func newResource(t *testing.T, typ string) *Resource {
 	return &Resource{
 		Type: typ,
@@ -140,6 +144,24 @@ func GenerateImportStateID(path string, attrs ...string) resource.ImportStateIdF
 	}
 }
 
+func GenerateImportStateLookupID(path, attr, field string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		resources, ok := state.RootModule().Resources[path]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", path) // nolint:forbidigo
+		}
+		v, ok := resources.Primary.Attributes[attr]
+		if !ok || v == "" {
+			return "", fmt.Errorf("attribute %q not found in %s", attr, path) // nolint:forbidigo
+		}
+		id := field + ":" + v
+		if projectID := resources.Primary.Attributes["project_id"]; projectID != "" {
+			id = projectID + "/" + id
+		}
+		return id, nil
+	}
+}
+
 func flatten(checks map[string]any, keypath string) map[string]any {
 	result := map[string]any{}
 	for k, v := range checks {